@@ -0,0 +1,75 @@
+package xelon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// BulkResult is the outcome of a bulk operation for a single item.
+type BulkResult[T any] struct {
+	Item T
+	Err  error
+}
+
+// BulkResults are the outcomes of a bulk operation in the order of the items.
+type BulkResults[T any] []BulkResult[T]
+
+// Err returns the errors of all failed items joined with errors.Join, or nil if all
+// items succeeded. Every error is prefixed with its item.
+func (r BulkResults[T]) Err() error {
+	var errs []error
+	for _, result := range r {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w", result.Item, result.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Failed returns the results of the failed items.
+func (r BulkResults[T]) Failed() BulkResults[T] {
+	var failed BulkResults[T]
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Bulk calls fn for every item with at most concurrency calls in flight (at least one)
+// and returns the outcome of every item. A failed item does not stop the others.
+// Once ctx is canceled, no further calls are started and the remaining items fail
+// with the error of ctx.
+//
+// The requests of fn pass through the client, so the limits configured with
+// WithRateLimit and WithMaxInFlight apply across all items.
+func Bulk[T any](ctx context.Context, items []T, concurrency int, fn func(ctx context.Context, item T) error) BulkResults[T] {
+	results := make(BulkResults[T], len(items))
+	sem := make(chan struct{}, max(concurrency, 1))
+
+	var wg sync.WaitGroup
+	for i, item := range items {
+		results[i].Item = item
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Go(func() {
+			defer func() { <-sem }()
+			results[i].Err = fn(ctx, item)
+		})
+	}
+	wg.Wait()
+
+	return results
+}
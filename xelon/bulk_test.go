@@ -0,0 +1,116 @@
+package xelon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulk_boundedConcurrency(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	results := Bulk(context.Background(), items, 3, func(ctx context.Context, item int) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			current := maxInFlight.Load()
+			if n <= current || maxInFlight.CompareAndSwap(current, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if item%4 == 0 {
+			return errors.New("failure")
+		}
+		return nil
+	})
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(3))
+	assert.Len(t, results, len(items))
+	for i, result := range results {
+		assert.Equal(t, items[i], result.Item)
+	}
+	assert.Equal(t, BulkResults[int]{{Item: 4, Err: errors.New("failure")}, {Item: 8, Err: errors.New("failure")}}, results.Failed())
+	assert.EqualError(t, results.Err(), "4: failure\n8: failure")
+}
+
+func TestBulk_success(t *testing.T) {
+	results := Bulk(context.Background(), []string{"a", "b"}, 0, func(ctx context.Context, item string) error {
+		return nil
+	})
+
+	assert.NoError(t, results.Err())
+	assert.Empty(t, results.Failed())
+}
+
+func TestBulk_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var mu sync.Mutex
+	var called []int
+
+	results := Bulk(ctx, []int{1, 2, 3, 4}, 1, func(ctx context.Context, item int) error {
+		mu.Lock()
+		called = append(called, item)
+		mu.Unlock()
+		if item == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	assert.Equal(t, []int{1, 2}, called)
+	assert.NoError(t, results[0].Err)
+	assert.NoError(t, results[1].Err)
+	assert.ErrorIs(t, results[2].Err, context.Canceled)
+	assert.ErrorIs(t, results[3].Err, context.Canceled)
+	assert.ErrorIs(t, results.Err(), context.Canceled)
+}
+
+func TestDevicesService_StopMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("POST /devices/{id}/stop", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") == "dev-2" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"device not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	results := client.Devices.StopMany(ctx, []string{"dev-1", "dev-2", "dev-3"}, 2)
+
+	failed := results.Failed()
+	if assert.Len(t, failed, 1) {
+		assert.Equal(t, "dev-2", failed[0].Item)
+		assert.True(t, IsNotFound(failed[0].Err))
+	}
+	assert.True(t, IsNotFound(results.Err()))
+}
+
+func TestSSHKeysService_DeleteMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var deleted []string
+	mux.HandleFunc("DELETE /ssh-keys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		deleted = append(deleted, r.PathValue("id"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	results := client.SSHKeys.DeleteMany(ctx, []string{"key-1", "key-2"}, 2)
+
+	assert.NoError(t, results.Err())
+	assert.ElementsMatch(t, []string{"key-1", "key-2"}, deleted)
+}
@@ -7,17 +7,21 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/go-querystring/query"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon/internal/redact"
 )
 
 const (
-	libraryVersion = "1.14.3"
+	libraryVersion = "1.15.0"
 
 	defaultBaseURL   = "https://hq.xelon.ch/api/v2/"
 	defaultMediaType = "application/json"
@@ -32,8 +36,20 @@ type Client struct {
 
 	httpClient *http.Client // HTTP client used to communicate with the API.
 	clientID   string       // ClientID for IP ranges.
-	token      string       // token for Xelon API.
-	userAgent  string       // User agent used when communicating with Xelon API.
+
+	tokenSource TokenSource // Source of the bearer token, consulted for every request.
+	userAgent   string      // User agent used when communicating with Xelon API.
+
+	retryPolicy *RetryPolicy  // Policy for retrying failed requests, nil disables retries.
+	rateLimiter *tokenBucket  // Limiter for requests per second, nil disables rate limiting.
+	inFlight    chan struct{} // Semaphore for concurrent requests, nil disables the limit.
+	logger      *slog.Logger  // Logger for requests and responses, nil disables logging.
+	logBodies   bool          // Whether request and response bodies are logged.
+	middlewares []Middleware  // Middlewares wrapping every API call, outermost first.
+
+	idempotencyKeys bool          // Whether POST requests get a random idempotency key.
+	dryRun          *planRecorder // Recorder of mutating requests in dry-run mode, nil sends all requests.
+	sortValidation  bool          // Whether unknown sort fields are rejected before sending a request.
 
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
@@ -115,6 +131,16 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
+// WithTimeout configures Client to use a specific timeout for every request attempt.
+// The http client is copied, so a client passed with WithHTTPClient is not modified.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(client *Client) {
+		httpClient := *client.httpClient
+		httpClient.Timeout = timeout
+		client.httpClient = &httpClient
+	}
+}
+
 // WithUserAgent configures Client to use a specific user agent.
 func WithUserAgent(userAgent string) ClientOption {
 	return func(client *Client) {
@@ -130,10 +156,12 @@ func NewClient(token string, opts ...ClientOption) *Client {
 	}
 
 	c := &Client{
-		baseURL:    baseUrl,
-		httpClient: httpClient,
-		token:      token,
-		userAgent:  defaultUserAgent,
+		baseURL:     baseUrl,
+		httpClient:  httpClient,
+		tokenSource: StaticTokenSource(token),
+		userAgent:   defaultUserAgent,
+
+		sortValidation: true,
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -165,6 +193,7 @@ func NewClient(token string, opts ...ClientOption) *Client {
 // NewRequest creates an API request. A relative URL can be provided in urlStr, in which case it is resolved
 // relative to the BaseURL of the Client. Relative URLs should always be specified without a preceding slash.
 // If specified, the value pointed to by body is JSON encoded and included as the request body.
+// The request body is replayable via http.Request.GetBody, so the request can be safely retried.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
 	if !strings.HasSuffix(c.baseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a traling slash, but %q does not", c.baseURL)
@@ -188,7 +217,9 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	}
 
 	if req.Header.Get("Authorization") == "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+		if err := c.authorize(req); err != nil {
+			return nil, err
+		}
 	}
 	req.Header.Set("Accept", defaultMediaType)
 	req.Header.Set("Content-Type", defaultMediaType)
@@ -201,18 +232,80 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRequestID     = "X-Request-Id"
+	headerCorrelationID = "X-Correlation-Id"
+)
+
 // Response is a Xelon response. This wraps the standard http.Response.
 type Response struct {
 	*http.Response
 
 	Meta *Meta
+
+	// Rate specifies the API rate limit of the client as reported by the response headers.
+	Rate Rate
+
+	// RequestID is the server-side id of the request, useful for support requests.
+	RequestID string
+
+	// DryRun reports whether the request was captured in dry-run mode instead of being sent.
+	DryRun bool
+}
+
+// Rate represents the rate limit for the current client.
+type Rate struct {
+	Limit     int       // Limit is the number of requests allowed in the current window.
+	Remaining int       // Remaining is the number of requests left in the current window.
+	Reset     time.Time // Reset is the time when the current window resets, zero if unknown.
 }
 
+func (v Rate) String() string { return Stringify(v) }
+
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in
 // the value pointed to by v, or returned as an error if an API error has occurred.
+//
+// The request passes through the middleware chain configured with WithMiddleware.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	req = req.WithContext(ctx)
-	resp, err := c.httpClient.Do(req)
+	// the key is set once, so all retries of the request share it
+	if req.Header.Get(IdempotencyKeyHeader) == "" {
+		key, err := c.idempotencyKey(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+	}
+	call := c.newCall(ctx, req.WithContext(ctx))
+
+	next := func(ctx context.Context, call *Call) (*Response, error) {
+		return c.do(ctx, call, v)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		next = c.middlewares[i].Wrap(next)
+	}
+
+	return next(ctx, call)
+}
+
+// do sends the request of call, retries it if needed and decodes the response into v.
+func (c *Client) do(ctx context.Context, call *Call, v interface{}) (*Response, error) {
+	if c.dryRun != nil && isMutating(call.Request) {
+		return c.capture(call, v)
+	}
+
+	req := call.Request.WithContext(ctx)
+	resp, err := c.send(ctx, call, req)
+	if err == nil {
+		// a rejected token might have been rotated, retry once with a fresh one
+		if retryReq, ok := c.reauthorize(ctx, req, resp); ok {
+			resp, err = c.send(ctx, call, retryReq)
+		}
+	}
 	if err != nil {
 		// if we got an error, and the context has been canceled, the context's error is more useful.
 		select {
@@ -262,12 +355,69 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return response, err
 }
 
+// roundTrip sends a single attempt of req while respecting the configured limits.
+func (c *Client) roundTrip(ctx context.Context, req *http.Request) (*http.Response, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if c.logger != nil {
+		resp = c.logRoundTrip(ctx, req, resp, err, time.Since(start))
+	}
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
 // newResponse creates a new Response for the provided http.Response. r must be not nil.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
+	response.Rate = parseRate(r, time.Now())
+	response.RequestID = parseRequestID(r)
 	return response
 }
 
+// parseRate parses the rate limit headers. If the reset header is missing,
+// the reset time is derived from the Retry-After header when present.
+func parseRate(r *http.Response, now time.Time) Rate {
+	var rate Rate
+	if r.Header == nil {
+		return rate
+	}
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		if epoch, err := strconv.ParseInt(reset, 10, 64); err == nil && epoch > 0 {
+			rate.Reset = time.Unix(epoch, 0)
+		}
+	} else if d, ok := parseRetryAfter(r.Header.Get("Retry-After"), now); ok {
+		rate.Reset = now.Add(d).Truncate(time.Second)
+	}
+	return rate
+}
+
+// parseRequestID returns the server-side request id or correlation id.
+func parseRequestID(r *http.Response) string {
+	if r.Header == nil {
+		return ""
+	}
+	if requestID := r.Header.Get(headerRequestID); requestID != "" {
+		return requestID
+	}
+	return r.Header.Get(headerCorrelationID)
+}
+
 // CheckResponse checks the API response for errors, and returns them if present. A response is considered
 // an error if it has a status code outside the 200 range.
 func CheckResponse(resp *Response) error {
@@ -286,16 +436,7 @@ func CheckResponse(resp *Response) error {
 	return errorResponse
 }
 
-// sanitizeURL redacts the password parameter from the URL which may be exposed by the user.
+// sanitizeURL redacts sensitive parameters like password or token from the URL which may be exposed by the user.
 func sanitizeURL(uri *url.URL) *url.URL {
-	if uri == nil {
-		return nil
-	}
-	params := uri.Query()
-	if len(params.Get("password")) > 0 {
-		params.Set("password", "REDACTED")
-		uri.RawQuery = params.Encode()
-	}
-
-	return uri
+	return redact.URL(uri)
 }
@@ -39,7 +39,9 @@ func TestClient_NewClient(t *testing.T) {
 
 	assert.NotNil(t, client.baseURL)
 	assert.Equal(t, fmt.Sprintf("%v/", server.URL), client.baseURL.String())
-	assert.Equal(t, "auth-token", client.token)
+	token, err := client.tokenSource.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "auth-token", token)
 }
 
 func TestClient_Defaults(t *testing.T) {
@@ -78,6 +80,16 @@ func TestClient_WithHTTPClient(t *testing.T) {
 	assert.Equal(t, 2*time.Second, client.httpClient.Timeout)
 }
 
+func TestClient_WithRetryPolicy(t *testing.T) {
+	client := NewClient("auth-token",
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 5}),
+	)
+
+	if assert.NotNil(t, client.retryPolicy) {
+		assert.Equal(t, 5, client.retryPolicy.MaxAttempts)
+	}
+}
+
 func TestClient_WithUserAgent(t *testing.T) {
 	client := NewClient("auth-token",
 		WithUserAgent("custom-user-agent"),
@@ -107,3 +119,54 @@ func mustTime(t *testing.T, timestamp string) *time.Time {
 
 	return &ts
 }
+
+func TestClient_newResponse_rateAndRequestID(t *testing.T) {
+	type testCase struct {
+		header            http.Header
+		expectedRate      Rate
+		expectedRequestID string
+	}
+	tests := map[string]testCase{
+		"no headers": {
+			header: http.Header{},
+		},
+		"rate limit headers": {
+			header: http.Header{
+				"X-Ratelimit-Limit":     []string{"60"},
+				"X-Ratelimit-Remaining": []string{"59"},
+				"X-Ratelimit-Reset":     []string{"1761570000"},
+				"X-Request-Id":          []string{"request-1"},
+			},
+			expectedRate:      Rate{Limit: 60, Remaining: 59, Reset: time.Unix(1761570000, 0)},
+			expectedRequestID: "request-1",
+		},
+		"correlation id": {
+			header: http.Header{
+				"X-Correlation-Id": []string{"correlation-1"},
+			},
+			expectedRequestID: "correlation-1",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := newResponse(&http.Response{Header: test.header})
+
+			assert.Equal(t, test.expectedRate, resp.Rate)
+			assert.Equal(t, test.expectedRequestID, resp.RequestID)
+		})
+	}
+}
+
+func TestClient_parseRate_resetFromRetryAfter(t *testing.T) {
+	now := time.Date(2025, 10, 27, 12, 0, 0, 0, time.UTC)
+	header := http.Header{
+		"X-Ratelimit-Limit":     []string{"60"},
+		"X-Ratelimit-Remaining": []string{"0"},
+		"Retry-After":           []string{"30"},
+	}
+
+	rate := parseRate(&http.Response{Header: header}, now)
+
+	assert.Equal(t, Rate{Limit: 60, Remaining: 0, Reset: now.Add(30 * time.Second)}, rate)
+}
@@ -10,6 +10,13 @@ const cloudsBasePath = "clouds"
 // CloudsService handles communication with the organization's cloud related methods of the Xelon REST API.
 type CloudsService service
 
+// CloudsAPI is the interface implemented by CloudsService.
+type CloudsAPI interface {
+	List(ctx context.Context, opts *CloudListOptions) ([]Cloud, *Response, error)
+}
+
+var _ CloudsAPI = (*CloudsService)(nil)
+
 type Cloud struct {
 	ID     string `json:"identifier,omitempty"`
 	Name   string `json:"name,omitempty"`
@@ -36,7 +43,7 @@ func (s *CloudsService) List(ctx context.Context, opts *CloudListOptions) ([]Clo
 	}
 
 	var clouds []Cloud
-	resp, err := s.client.Do(ctx, req, &clouds)
+	resp, err := s.client.Do(withOperation(ctx, "CloudsService.List"), req, &clouds)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -0,0 +1,244 @@
+package xelon
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by NewClientFromEnv.
+const (
+	EnvToken      = "XELON_TOKEN"       // Bearer token for the Xelon API.
+	EnvBaseURL    = "XELON_BASE_URL"    // Base URL of the Xelon API.
+	EnvClientID   = "XELON_CLIENT_ID"   // Client ID sent as "X-User-Id" header.
+	EnvUserAgent  = "XELON_USER_AGENT"  // User agent sent with every request.
+	EnvTimeout    = "XELON_TIMEOUT"     // HTTP client timeout, e.g. "30s".
+	EnvMaxRetries = "XELON_MAX_RETRIES" // Maximum number of retries, 0 disables retries.
+	EnvProfile    = "XELON_PROFILE"     // Name of the profile in the config file.
+	EnvConfigFile = "XELON_CONFIG_FILE" // Path of the config file.
+)
+
+const (
+	defaultProfile = "default"           // Profile used if no profile name is given.
+	configFileName = "xelon/config.yaml" // Path of the config file relative to the user config directory.
+)
+
+// ErrProfileNotFound is returned if the requested profile does not exist in the config file.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Config represents a config file with named profiles, e.g. ~/.config/xelon/config.yaml:
+//
+//	profiles:
+//	  default:
+//	    token: my-secret-token
+//	  staging:
+//	    token: my-staging-token
+//	    baseURL: https://staging.example.com/api/v2/
+//	    clientID: my-client-id
+//	    timeout: 30s
+//	    retry:
+//	      maxAttempts: 5
+type Config struct {
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// Profile contains the client configuration of a single profile. Empty fields keep the client defaults.
+type Profile struct {
+	Token     string        `yaml:"token,omitempty"`
+	BaseURL   string        `yaml:"baseURL,omitempty"`
+	ClientID  string        `yaml:"clientID,omitempty"`
+	UserAgent string        `yaml:"userAgent,omitempty"`
+	Timeout   time.Duration `yaml:"timeout,omitempty"`
+	Retry     *ProfileRetry `yaml:"retry,omitempty"`
+}
+
+// ProfileRetry contains the retry settings of a profile, see RetryPolicy. Settings
+// which are not set keep the values of DefaultRetryPolicy.
+type ProfileRetry struct {
+	MaxAttempts        int           `yaml:"maxAttempts,omitempty"`
+	MinBackoff         time.Duration `yaml:"minBackoff,omitempty"`
+	MaxBackoff         time.Duration `yaml:"maxBackoff,omitempty"`
+	RetryNonIdempotent bool          `yaml:"retryNonIdempotent,omitempty"`
+}
+
+func (v Profile) String() string {
+	if v.Token != "" {
+		v.Token = "REDACTED"
+	}
+	return Stringify(v)
+}
+
+// DefaultConfigPath returns the default path of the config file, which is
+// xelon/config.yaml in the user config directory (e.g. ~/.config/xelon/config.yaml on Linux).
+func DefaultConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, configFileName), nil
+}
+
+// LoadConfig reads the config file at path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := new(Config)
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %v: %w", path, err)
+	}
+	return config, nil
+}
+
+// LoadProfile reads the profile identified by name from the config file at path.
+// If name is empty, the "default" profile is used.
+func LoadProfile(path, name string) (*Profile, error) {
+	if name == "" {
+		name = defaultProfile
+	}
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	profile, ok := config.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("%q in %v: %w", name, path, ErrProfileNotFound)
+	}
+	return &profile, nil
+}
+
+// ClientOptions returns the client options configured by the profile.
+func (v *Profile) ClientOptions() []ClientOption {
+	var opts []ClientOption
+	if v.Token != "" {
+		opts = append(opts, WithTokenSource(StaticTokenSource(v.Token)))
+	}
+	if v.BaseURL != "" {
+		opts = append(opts, WithBaseURL(v.BaseURL))
+	}
+	if v.ClientID != "" {
+		opts = append(opts, WithClientID(v.ClientID))
+	}
+	if v.UserAgent != "" {
+		opts = append(opts, WithUserAgent(v.UserAgent))
+	}
+	if v.Timeout > 0 {
+		opts = append(opts, WithTimeout(v.Timeout))
+	}
+	if v.Retry != nil {
+		policy := DefaultRetryPolicy()
+		if v.Retry.MaxAttempts > 0 {
+			policy.MaxAttempts = v.Retry.MaxAttempts
+		}
+		if v.Retry.MinBackoff > 0 {
+			policy.MinBackoff = v.Retry.MinBackoff
+		}
+		if v.Retry.MaxBackoff > 0 {
+			policy.MaxBackoff = v.Retry.MaxBackoff
+		}
+		policy.RetryNonIdempotent = v.Retry.RetryNonIdempotent
+		opts = append(opts, WithRetryPolicy(policy))
+	}
+	return opts
+}
+
+// applyEnv overrides the profile with values of the environment variables.
+func (v *Profile) applyEnv() error {
+	if token := os.Getenv(EnvToken); token != "" {
+		v.Token = token
+	}
+	if baseURL := os.Getenv(EnvBaseURL); baseURL != "" {
+		v.BaseURL = baseURL
+	}
+	if clientID := os.Getenv(EnvClientID); clientID != "" {
+		v.ClientID = clientID
+	}
+	if userAgent := os.Getenv(EnvUserAgent); userAgent != "" {
+		v.UserAgent = userAgent
+	}
+	if timeout := os.Getenv(EnvTimeout); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return fmt.Errorf("invalid %v: %w", EnvTimeout, err)
+		}
+		v.Timeout = d
+	}
+	if maxRetries := os.Getenv(EnvMaxRetries); maxRetries != "" {
+		n, err := strconv.Atoi(maxRetries)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid %v: %q must be a non-negative number", EnvMaxRetries, maxRetries)
+		}
+		if v.Retry == nil {
+			v.Retry = new(ProfileRetry)
+		}
+		v.Retry.MaxAttempts = n + 1
+	}
+	return nil
+}
+
+// NewClientFromEnv returns a new Xelon API client configured from the environment.
+//
+// The configuration is resolved in the following order, later sources override earlier ones:
+//  1. the profile named by XELON_PROFILE (or "default") in the config file at XELON_CONFIG_FILE
+//     (or DefaultConfigPath). A missing config file is ignored unless set explicitly.
+//  2. the environment variables XELON_TOKEN, XELON_BASE_URL, XELON_CLIENT_ID,
+//     XELON_USER_AGENT, XELON_TIMEOUT and XELON_MAX_RETRIES.
+//  3. the explicitly passed opts.
+func NewClientFromEnv(opts ...ClientOption) (*Client, error) {
+	profile := new(Profile)
+
+	path, explicitPath := os.LookupEnv(EnvConfigFile)
+	name, explicitName := os.LookupEnv(EnvProfile)
+	if !explicitPath {
+		var err error
+		if path, err = DefaultConfigPath(); err != nil && explicitName {
+			return nil, err
+		}
+	}
+	if path != "" {
+		loaded, err := LoadProfile(path, name)
+		switch {
+		case err == nil:
+			profile = loaded
+		case errors.Is(err, fs.ErrNotExist) && !explicitPath && !explicitName:
+			// the config file is optional
+		case errors.Is(err, ErrProfileNotFound) && !explicitName:
+			// the default profile is optional
+		default:
+			return nil, err
+		}
+	}
+
+	if err := profile.applyEnv(); err != nil {
+		return nil, err
+	}
+
+	return newClientFromProfile(profile, opts...)
+}
+
+// NewClientFromProfile returns a new Xelon API client configured by the profile identified
+// by name in the config file at path. Explicitly passed opts override the profile.
+func NewClientFromProfile(path, name string, opts ...ClientOption) (*Client, error) {
+	profile, err := LoadProfile(path, name)
+	if err != nil {
+		return nil, err
+	}
+	return newClientFromProfile(profile, opts...)
+}
+
+func newClientFromProfile(profile *Profile, opts ...ClientOption) (*Client, error) {
+	client := NewClient(profile.Token, append(profile.ClientOptions(), opts...)...)
+	if token, ok := client.tokenSource.(staticTokenSource); ok && token == "" {
+		return nil, fmt.Errorf("token must be set via profile, %v or WithTokenSource: %w", EnvToken, ErrEmptyToken)
+	}
+	return client, nil
+}
@@ -0,0 +1,193 @@
+package xelon
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigFile = `
+profiles:
+  default:
+    token: default-token
+  staging:
+    token: staging-token
+    baseURL: https://staging.xelon.ch/api/v2/
+    clientID: staging-client-id
+    userAgent: staging-agent
+    timeout: 15s
+    retry:
+      maxAttempts: 5
+      minBackoff: 1s
+      retryNonIdempotent: true
+`
+
+func writeTestConfig(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testConfigFile), 0o600))
+	return path
+}
+
+// clearConfigEnv isolates tests from the environment of the developer.
+func clearConfigEnv(t *testing.T) {
+	t.Helper()
+
+	for _, name := range []string{EnvToken, EnvBaseURL, EnvClientID, EnvUserAgent, EnvTimeout, EnvMaxRetries, EnvProfile} {
+		t.Setenv(name, "")
+		_ = os.Unsetenv(name)
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv(EnvConfigFile, "")
+	_ = os.Unsetenv(EnvConfigFile)
+}
+
+func TestConfig_LoadProfile(t *testing.T) {
+	path := writeTestConfig(t)
+
+	profile, err := LoadProfile(path, "staging")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Profile{
+		Token:     "staging-token",
+		BaseURL:   "https://staging.xelon.ch/api/v2/",
+		ClientID:  "staging-client-id",
+		UserAgent: "staging-agent",
+		Timeout:   15 * time.Second,
+		Retry: &ProfileRetry{
+			MaxAttempts:        5,
+			MinBackoff:         time.Second,
+			RetryNonIdempotent: true,
+		},
+	}, profile)
+	assert.NotContains(t, profile.String(), "staging-token")
+}
+
+func TestConfig_LoadProfile_errors(t *testing.T) {
+	path := writeTestConfig(t)
+
+	_, err := LoadProfile(path, "unknown")
+	assert.ErrorIs(t, err, ErrProfileNotFound)
+
+	_, err = LoadProfile(filepath.Join(t.TempDir(), "missing.yaml"), "")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestConfig_NewClientFromProfile(t *testing.T) {
+	path := writeTestConfig(t)
+
+	client, err := NewClientFromProfile(path, "staging", WithUserAgent("explicit-agent"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://staging.xelon.ch/api/v2/", client.baseURL.String())
+	assert.Equal(t, "staging-client-id", client.clientID)
+	assert.Equal(t, "explicit-agent", client.userAgent)
+	assert.Equal(t, 15*time.Second, client.httpClient.Timeout)
+	if assert.NotNil(t, client.retryPolicy) {
+		assert.Equal(t, 5, client.retryPolicy.MaxAttempts)
+		assert.Equal(t, time.Second, client.retryPolicy.MinBackoff)
+		assert.True(t, client.retryPolicy.RetryNonIdempotent)
+	}
+	token, _ := client.tokenSource.Token()
+	assert.Equal(t, "staging-token", token)
+}
+
+func TestConfig_ProfileClientOptions_retryDefaults(t *testing.T) {
+	profile := &Profile{Token: "token", Retry: &ProfileRetry{MinBackoff: time.Second}}
+
+	client := NewClient(profile.Token, profile.ClientOptions()...)
+
+	if assert.NotNil(t, client.retryPolicy) {
+		assert.Equal(t, DefaultRetryPolicy().MaxAttempts, client.retryPolicy.MaxAttempts)
+		assert.Equal(t, time.Second, client.retryPolicy.MinBackoff)
+	}
+}
+
+func TestConfig_NewClientFromEnv(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(EnvConfigFile, writeTestConfig(t))
+	t.Setenv(EnvProfile, "staging")
+	t.Setenv(EnvToken, "env-token")
+	t.Setenv(EnvTimeout, "45s")
+	t.Setenv(EnvMaxRetries, "0")
+
+	client, err := NewClientFromEnv(WithClientID("explicit-client-id"))
+
+	require.NoError(t, err)
+	token, _ := client.tokenSource.Token()
+	assert.Equal(t, "env-token", token)
+	assert.Equal(t, "https://staging.xelon.ch/api/v2/", client.baseURL.String())
+	assert.Equal(t, "explicit-client-id", client.clientID)
+	assert.Equal(t, 45*time.Second, client.httpClient.Timeout)
+	assert.Equal(t, 1, client.retryPolicy.MaxAttempts)
+}
+
+func TestConfig_NewClientFromEnv_withoutConfigFile(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(EnvToken, "env-token")
+
+	client, err := NewClientFromEnv()
+
+	require.NoError(t, err)
+	token, _ := client.tokenSource.Token()
+	assert.Equal(t, "env-token", token)
+	assert.Equal(t, defaultBaseURL, client.baseURL.String())
+}
+
+func TestConfig_NewClientFromEnv_errors(t *testing.T) {
+	type testCase struct {
+		env            map[string]string
+		withConfigFile bool
+		expectedError  error
+	}
+	tests := map[string]testCase{
+		"missing token": {
+			env:           map[string]string{},
+			expectedError: ErrEmptyToken,
+		},
+		"missing explicit profile": {
+			env:            map[string]string{EnvProfile: "unknown", EnvToken: "env-token"},
+			withConfigFile: true,
+			expectedError:  ErrProfileNotFound,
+		},
+		"explicit profile without config file": {
+			env:           map[string]string{EnvProfile: "staging", EnvToken: "env-token"},
+			expectedError: os.ErrNotExist,
+		},
+		"missing explicit config file": {
+			env:           map[string]string{EnvConfigFile: "/nonexistent/config.yaml", EnvToken: "env-token"},
+			expectedError: os.ErrNotExist,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			clearConfigEnv(t)
+			for key, value := range test.env {
+				t.Setenv(key, value)
+			}
+			if test.withConfigFile {
+				t.Setenv(EnvConfigFile, writeTestConfig(t))
+			}
+
+			_, err := NewClientFromEnv()
+
+			assert.ErrorIs(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestConfig_NewClientFromEnv_invalidValues(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(EnvToken, "env-token")
+	t.Setenv(EnvMaxRetries, "many")
+
+	_, err := NewClientFromEnv()
+
+	assert.EqualError(t, err, `invalid XELON_MAX_RETRIES: "many" must be a non-negative number`)
+}
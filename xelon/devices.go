@@ -8,6 +8,7 @@ import (
 	"iter"
 	"net/http"
 	"net/netip"
+	"slices"
 )
 
 const deviceBasePath = "devices"
@@ -15,6 +16,35 @@ const deviceBasePath = "devices"
 // DevicesService handles communication with the devices related methods of the Xelon REST API.
 type DevicesService service
 
+// DevicesAPI is the interface implemented by DevicesService.
+type DevicesAPI interface {
+	List(ctx context.Context, opts *DeviceListOptions) ([]Device, *Response, error)
+	All(ctx context.Context, opts *DeviceListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Device, *Response], func() error)
+	Get(ctx context.Context, deviceID string) (*Device, *Response, error)
+	GetNetworkInfo(ctx context.Context, deviceID string) ([]DeviceNetwork, *Response, error)
+	Create(ctx context.Context, createRequest *DeviceCreateRequest) (*Device, *Response, error)
+	CreateOrFind(ctx context.Context, createRequest *DeviceCreateRequest) (*Device, *Response, error)
+	Update(ctx context.Context, deviceID string, updateRequest *DeviceUpdateRequest) (*Device, *Response, error)
+	UpdateDisk(ctx context.Context, deviceID string, updateRequest *DeviceUpdateDiskRequest) (*Device, *Response, error)
+	UpdateHardware(ctx context.Context, deviceID string, updateRequest *DeviceUpdateHardwareRequest) (*Device, *Response, error)
+	UpdateHotAddOptions(ctx context.Context, deviceID string, updateRequest *DeviceUpdateHotAddRequest) (*Device, *Response, error)
+	Delete(ctx context.Context, deviceID string) (*Response, error)
+	DeleteMany(ctx context.Context, deviceIDs []string, concurrency int) BulkResults[string]
+	Start(ctx context.Context, deviceID string) (*Response, error)
+	Stop(ctx context.Context, deviceID string) (*Response, error)
+	Reboot(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error)
+	Reset(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error)
+	Shutdown(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error)
+	Suspend(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error)
+	Resume(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error)
+	Power(ctx context.Context, deviceID string, action PowerAction, opts *PowerOptions) (*Device, *Response, error)
+	StartMany(ctx context.Context, deviceIDs []string, concurrency int) BulkResults[string]
+	StopMany(ctx context.Context, deviceIDs []string, concurrency int) BulkResults[string]
+	WaitForPowerState(ctx context.Context, deviceID string, poweredOn bool, opts *WaitOptions) (*Device, error)
+}
+
+var _ DevicesAPI = (*DevicesService)(nil)
+
 // Device represents a Xelon device (virtual machine).
 type Device struct {
 	CPUCores              int             `json:"cpu,omitempty"`
@@ -140,10 +170,32 @@ type DeviceUpdateHotAddRequest struct {
 	EnableRAMHotAdd      bool `json:"ramHotAdd"`
 }
 
+// DeviceSort is a field devices can be sorted by, use SortDesc for descending order.
+type DeviceSort string
+
+const (
+	DeviceSortDisplayName DeviceSort = "displayName"
+	DeviceSortHostName    DeviceSort = "hostName"
+	DeviceSortState       DeviceSort = "state"
+)
+
+func (DeviceSort) sortFields() []string {
+	return sortFieldNames(
+		DeviceSortDisplayName,
+		DeviceSortHostName,
+		DeviceSortState,
+	)
+}
+
 // DeviceListOptions specifies the optional parameters to the DevicesService.List.
 type DeviceListOptions struct {
-	Sort   string `url:"sort,omitempty"`
-	Search string `url:"search,omitempty"`
+	Sort   DeviceSort `url:"sort,omitempty"`
+	Search string     `url:"search,omitempty"`
+
+	// CloudID, TenantID and State filter the list by cloud, tenant and state.
+	CloudID  string `url:"cloudIdentifier,omitempty"`
+	TenantID string `url:"tenantIdentifier,omitempty"`
+	State    *int   `url:"state,omitempty"`
 
 	ListOptions
 }
@@ -164,7 +216,7 @@ func (v DeviceNetwork) String() string { return Stringify(v) }
 
 // List provides a list of all devices.
 func (s *DevicesService) List(ctx context.Context, opts *DeviceListOptions) ([]Device, *Response, error) {
-	path, err := addOptions(deviceBasePath, opts)
+	path, err := s.client.addListOptions(deviceBasePath, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -175,7 +227,7 @@ func (s *DevicesService) List(ctx context.Context, opts *DeviceListOptions) ([]D
 	}
 
 	root := new(devicesRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "DevicesService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -189,8 +241,8 @@ func (s *DevicesService) List(ctx context.Context, opts *DeviceListOptions) ([]D
 // All returns an iterator to paginate over all devices.
 //
 // The return iterator can be used in a for...range loop to easily process all devices.
-func (s *DevicesService) All(ctx context.Context, opts *ListOptions) (iter.Seq2[Device, *Response], func() error) {
-	return newPaginator[Device](ctx, s.client, deviceBasePath, opts)
+func (s *DevicesService) All(ctx context.Context, opts *DeviceListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Device, *Response], func() error) {
+	return newPaginator[Device](withOperation(ctx, "DevicesService.All"), s.client, deviceBasePath, opts, paginatorOpts...)
 }
 
 // Get provides detailed information for device identified by id.
@@ -206,7 +258,7 @@ func (s *DevicesService) Get(ctx context.Context, deviceID string) (*Device, *Re
 	}
 
 	device := new(Device)
-	resp, err := s.client.Do(ctx, req, device)
+	resp, err := s.client.Do(withOperation(ctx, "DevicesService.Get"), req, device)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -227,7 +279,7 @@ func (s *DevicesService) GetNetworkInfo(ctx context.Context, deviceID string) ([
 	}
 
 	var deviceNetworks []DeviceNetwork
-	resp, err := s.client.Do(ctx, req, &deviceNetworks)
+	resp, err := s.client.Do(withOperation(ctx, "DevicesService.GetNetworkInfo"), req, &deviceNetworks)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -248,7 +300,7 @@ func (s *DevicesService) Create(ctx context.Context, createRequest *DeviceCreate
 	}
 
 	deviceRoot := new(deviceRoot)
-	resp, err := s.client.Do(ctx, req, deviceRoot)
+	resp, err := s.client.Do(withOperation(ctx, "DevicesService.Create"), req, deviceRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -256,6 +308,34 @@ func (s *DevicesService) Create(ctx context.Context, createRequest *DeviceCreate
 	return deviceRoot.Device, resp, nil
 }
 
+// CreateOrFind makes a new device like Create, but can be retried safely. If the outcome
+// of the request is unknown, e.g. because of a client-side timeout, the device is looked
+// up by its host name before the request is retried. An existing device with the same
+// host name is returned instead of creating a duplicate.
+func (s *DevicesService) CreateOrFind(ctx context.Context, createRequest *DeviceCreateRequest) (*Device, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create device: payload must be supplied")
+	}
+	if createRequest.HostName == "" {
+		return nil, nil, errors.New("failed to create device: host name must be supplied")
+	}
+
+	return createOrFind(ctx, s.client,
+		func(ctx context.Context) (*Device, *Response, error) {
+			return s.Create(ctx, createRequest)
+		},
+		func(ctx context.Context) (*Device, *Response, error) {
+			devices, errFunc := s.All(ctx, nil)
+			for device, resp := range devices {
+				if device.HostName == createRequest.HostName {
+					return &device, resp, nil
+				}
+			}
+			return nil, nil, errFunc()
+		},
+	)
+}
+
 // Update changes device identified by id.
 func (s *DevicesService) Update(ctx context.Context, deviceID string, updateRequest *DeviceUpdateRequest) (*Device, *Response, error) {
 	if deviceID == "" {
@@ -272,7 +352,7 @@ func (s *DevicesService) Update(ctx context.Context, deviceID string, updateRequ
 	}
 
 	deviceRoot := new(deviceRoot)
-	resp, err := s.client.Do(ctx, req, deviceRoot)
+	resp, err := s.client.Do(withOperation(ctx, "DevicesService.Update"), req, deviceRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -295,7 +375,7 @@ func (s *DevicesService) UpdateDisk(ctx context.Context, deviceID string, update
 	}
 
 	deviceRoot := new(deviceRoot)
-	resp, err := s.client.Do(ctx, req, deviceRoot)
+	resp, err := s.client.Do(withOperation(ctx, "DevicesService.UpdateDisk"), req, deviceRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -319,7 +399,7 @@ func (s *DevicesService) UpdateHardware(ctx context.Context, deviceID string, up
 	}
 
 	deviceRoot := new(deviceRoot)
-	resp, err := s.client.Do(ctx, req, deviceRoot)
+	resp, err := s.client.Do(withOperation(ctx, "DevicesService.UpdateHardware"), req, deviceRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -343,7 +423,7 @@ func (s *DevicesService) UpdateHotAddOptions(ctx context.Context, deviceID strin
 	}
 
 	deviceRoot := new(deviceRoot)
-	resp, err := s.client.Do(ctx, req, deviceRoot)
+	resp, err := s.client.Do(withOperation(ctx, "DevicesService.UpdateHotAddOptions"), req, deviceRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -363,31 +443,149 @@ func (s *DevicesService) Delete(ctx context.Context, deviceID string) (*Response
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "DevicesService.Delete"), req, nil)
+}
+
+// DeleteMany removes the devices identified by ids with at most concurrency requests
+// in flight, see Bulk.
+func (s *DevicesService) DeleteMany(ctx context.Context, deviceIDs []string, concurrency int) BulkResults[string] {
+	return Bulk(ctx, deviceIDs, concurrency, func(ctx context.Context, deviceID string) error {
+		_, err := s.Delete(ctx, deviceID)
+		return err
+	})
+}
+
+// PowerAction is an action changing the power state of a device.
+type PowerAction string
+
+const (
+	// PowerActionStart powers the device on.
+	PowerActionStart PowerAction = "start"
+
+	// PowerActionStop sends an ACPI shutdown to the device.
+	PowerActionStop PowerAction = "stop"
+
+	// PowerActionShutdown shuts the guest operating system down gracefully via the
+	// guest tools.
+	PowerActionShutdown PowerAction = "shutdown"
+
+	// PowerActionReboot restarts the guest operating system gracefully via the guest tools.
+	PowerActionReboot PowerAction = "reboot"
+
+	// PowerActionReset restarts the device hard, like pressing the reset button.
+	PowerActionReset PowerAction = "reset"
+
+	// PowerActionSuspend suspends the device, keeping its memory.
+	PowerActionSuspend PowerAction = "suspend"
+
+	// PowerActionResume resumes the suspended device.
+	PowerActionResume PowerAction = "resume"
+)
+
+// powerActions are the actions supported by the API.
+var powerActions = []PowerAction{
+	PowerActionStart,
+	PowerActionStop,
+	PowerActionShutdown,
+	PowerActionReboot,
+	PowerActionReset,
+	PowerActionSuspend,
+	PowerActionResume,
+}
+
+// PoweredOn reports whether the device is powered on after the action.
+func (a PowerAction) PoweredOn() bool {
+	switch a {
+	case PowerActionStop, PowerActionShutdown, PowerActionSuspend:
+		return false
+	default:
+		return true
+	}
+}
+
+// PowerOptions specifies the optional parameters to the DevicesService.Power and the
+// power actions like DevicesService.Reboot.
+type PowerOptions struct {
+	// Wait for the device to reach the power state of the action, see PowerAction.PoweredOn.
+	// For PowerActionReboot and PowerActionReset, it waits until the device is powered on.
+	Wait bool
+
+	// WaitOptions configures the wait, the defaults of Wait are used if nil.
+	WaitOptions *WaitOptions
 }
 
 // Start sends 'start' action and starts device identified by id.
 func (s *DevicesService) Start(ctx context.Context, deviceID string) (*Response, error) {
-	if deviceID == "" {
-		return nil, errors.New("failed to start device: id must be supplied")
-	}
+	return s.power(withOperation(ctx, "DevicesService.Start"), deviceID, PowerActionStart)
+}
 
-	path := fmt.Sprintf("%v/%v/start", deviceBasePath, deviceID)
-	req, err := s.client.NewRequest(http.MethodPost, path, nil)
-	if err != nil {
-		return nil, err
+// Stop sends an ACPI shutdown to device identified by id. Use Shutdown for a graceful
+// shutdown via the guest tools.
+func (s *DevicesService) Stop(ctx context.Context, deviceID string) (*Response, error) {
+	return s.power(withOperation(ctx, "DevicesService.Stop"), deviceID, PowerActionStop)
+}
+
+// Reboot restarts the guest operating system of device identified by id gracefully
+// via the guest tools. If opts.Wait is set, it waits until the device is powered on.
+func (s *DevicesService) Reboot(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error) {
+	return s.powerAndWait(withOperation(ctx, "DevicesService.Reboot"), deviceID, PowerActionReboot, opts)
+}
+
+// Reset restarts device identified by id hard, without shutting the guest operating
+// system down. If opts.Wait is set, it waits until the device is powered on.
+func (s *DevicesService) Reset(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error) {
+	return s.powerAndWait(withOperation(ctx, "DevicesService.Reset"), deviceID, PowerActionReset, opts)
+}
+
+// Shutdown shuts the guest operating system of device identified by id down gracefully
+// via the guest tools. If opts.Wait is set, it waits until the device is powered off.
+func (s *DevicesService) Shutdown(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error) {
+	return s.powerAndWait(withOperation(ctx, "DevicesService.Shutdown"), deviceID, PowerActionShutdown, opts)
+}
+
+// Suspend suspends device identified by id. If opts.Wait is set, it waits until the
+// device is powered off.
+func (s *DevicesService) Suspend(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error) {
+	return s.powerAndWait(withOperation(ctx, "DevicesService.Suspend"), deviceID, PowerActionSuspend, opts)
+}
+
+// Resume resumes the suspended device identified by id. If opts.Wait is set, it waits
+// until the device is powered on.
+func (s *DevicesService) Resume(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error) {
+	return s.powerAndWait(withOperation(ctx, "DevicesService.Resume"), deviceID, PowerActionResume, opts)
+}
+
+// Power sends action to device identified by id. If opts.Wait is set, it waits until
+// the device reaches the power state of the action and returns the last fetched device.
+func (s *DevicesService) Power(ctx context.Context, deviceID string, action PowerAction, opts *PowerOptions) (*Device, *Response, error) {
+	return s.powerAndWait(withOperation(ctx, "DevicesService.Power"), deviceID, action, opts)
+}
+
+// powerAndWait sends action to device identified by id and waits for the power state
+// of the action if opts.Wait is set.
+func (s *DevicesService) powerAndWait(ctx context.Context, deviceID string, action PowerAction, opts *PowerOptions) (*Device, *Response, error) {
+	resp, err := s.power(ctx, deviceID, action)
+	if err != nil || opts == nil || !opts.Wait {
+		return nil, resp, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	device, err := s.WaitForPowerState(ctx, deviceID, action.PoweredOn(), opts.WaitOptions)
+	return device, resp, err
 }
 
-// Stop sends an ACPI shutdown to device identified by id.
-func (s *DevicesService) Stop(ctx context.Context, deviceID string) (*Response, error) {
+// power sends action to device identified by id.
+func (s *DevicesService) power(ctx context.Context, deviceID string, action PowerAction) (*Response, error) {
+	if action == "" {
+		return nil, errors.New("failed to change device power state: action must be supplied")
+	}
+	if !slices.Contains(powerActions, action) {
+		return nil, fmt.Errorf("failed to change device power state: unknown action %q", action)
+	}
 	if deviceID == "" {
-		return nil, errors.New("failed to stop device: id must be supplied")
+		return nil, fmt.Errorf("failed to %v device: id must be supplied", action)
 	}
 
-	path := fmt.Sprintf("%v/%v/stop", deviceBasePath, deviceID)
+	path := fmt.Sprintf("%v/%v/%v", deviceBasePath, deviceID, action)
 	req, err := s.client.NewRequest(http.MethodPost, path, nil)
 	if err != nil {
 		return nil, err
@@ -395,3 +593,40 @@ func (s *DevicesService) Stop(ctx context.Context, deviceID string) (*Response,
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// StartMany starts the devices identified by ids with at most concurrency requests in
+// flight, see Bulk.
+func (s *DevicesService) StartMany(ctx context.Context, deviceIDs []string, concurrency int) BulkResults[string] {
+	return Bulk(ctx, deviceIDs, concurrency, func(ctx context.Context, deviceID string) error {
+		_, err := s.Start(ctx, deviceID)
+		return err
+	})
+}
+
+// StopMany stops the devices identified by ids with at most concurrency requests in
+// flight, see Bulk.
+func (s *DevicesService) StopMany(ctx context.Context, deviceIDs []string, concurrency int) BulkResults[string] {
+	return Bulk(ctx, deviceIDs, concurrency, func(ctx context.Context, deviceID string) error {
+		_, err := s.Stop(ctx, deviceID)
+		return err
+	})
+}
+
+// WaitForPowerState waits until the device identified by id is powered on or off,
+// e.g. after calling Start or Stop. It returns the last fetched device.
+func (s *DevicesService) WaitForPowerState(ctx context.Context, deviceID string, poweredOn bool, opts *WaitOptions) (*Device, error) {
+	if deviceID == "" {
+		return nil, errors.New("failed to wait for device power state: id must be supplied")
+	}
+
+	return Wait(ctx,
+		func(ctx context.Context) (*Device, error) {
+			device, _, err := s.Get(ctx, deviceID)
+			return device, err
+		},
+		func(device *Device) (bool, error) {
+			return device.PoweredOn == poweredOn, nil
+		},
+		opts,
+	)
+}
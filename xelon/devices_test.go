@@ -1,11 +1,17 @@
 package xelon
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
 	"net/netip"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDevices_DeviceNetworkIPAddresses_UnmarshalJSON(t *testing.T) {
@@ -80,3 +86,125 @@ func TestDevices_DeviceNetworkIPAddresses_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestDevicesService_powerActions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var actions []string
+	mux.HandleFunc("POST /devices/dev-1/{action}", func(w http.ResponseWriter, r *http.Request) {
+		actions = append(actions, r.PathValue("action"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Devices.Start(ctx, "dev-1")
+	require.NoError(t, err)
+	_, err = client.Devices.Stop(ctx, "dev-1")
+	require.NoError(t, err)
+	for _, fn := range []func(ctx context.Context, deviceID string, opts *PowerOptions) (*Device, *Response, error){
+		client.Devices.Reboot,
+		client.Devices.Reset,
+		client.Devices.Shutdown,
+		client.Devices.Suspend,
+		client.Devices.Resume,
+	} {
+		_, _, err := fn(ctx, "dev-1", nil)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"start", "stop", "reboot", "reset", "shutdown", "suspend", "resume"}, actions)
+}
+
+func TestDevicesService_Power(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var polls atomic.Int32
+	mux.HandleFunc("POST /devices/dev-1/shutdown", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /devices/dev-1", func(w http.ResponseWriter, r *http.Request) {
+		poweredOn := polls.Add(1) < 3
+		_, _ = fmt.Fprintf(w, `{"identifier":"dev-1","isPoweredOn":%v}`, poweredOn)
+	})
+
+	device, _, err := client.Devices.Power(ctx, "dev-1", PowerActionShutdown, &PowerOptions{
+		Wait:        true,
+		WaitOptions: &WaitOptions{Interval: time.Millisecond},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &Device{ID: "dev-1", PoweredOn: false}, device)
+	assert.Equal(t, int32(3), polls.Load())
+
+	device, _, err = client.Devices.Power(ctx, "dev-1", PowerActionShutdown, nil)
+
+	assert.NoError(t, err)
+	assert.Nil(t, device, "device must not be fetched without wait")
+	assert.Equal(t, int32(3), polls.Load())
+}
+
+func TestDevicesService_Power_emptyArguments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := client.Devices.Reboot(ctx, "", nil)
+	assert.EqualError(t, err, "failed to reboot device: id must be supplied")
+
+	_, _, err = client.Devices.Power(ctx, "dev-1", "", nil)
+	assert.EqualError(t, err, "failed to change device power state: action must be supplied")
+}
+
+func TestDevicesService_Power_waitForRestart(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var actions []string
+	mux.HandleFunc("POST /devices/dev-1/{action}", func(w http.ResponseWriter, r *http.Request) {
+		actions = append(actions, r.PathValue("action"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	var polls atomic.Int32
+	mux.HandleFunc("GET /devices/dev-1", func(w http.ResponseWriter, r *http.Request) {
+		// the device is powered off while restarting
+		poweredOn := polls.Add(1)%2 == 0
+		_, _ = fmt.Fprintf(w, `{"identifier":"dev-1","isPoweredOn":%v}`, poweredOn)
+	})
+	opts := &PowerOptions{Wait: true, WaitOptions: &WaitOptions{Interval: time.Millisecond}}
+
+	device, _, err := client.Devices.Reboot(ctx, "dev-1", opts)
+	require.NoError(t, err)
+	assert.True(t, device.PoweredOn)
+
+	device, _, err = client.Devices.Power(ctx, "dev-1", PowerActionReset, opts)
+	require.NoError(t, err)
+	assert.True(t, device.PoweredOn)
+
+	assert.Equal(t, []string{"reboot", "reset"}, actions)
+	assert.Equal(t, int32(4), polls.Load())
+}
+
+func TestDevicesService_Power_unknownAction(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requested := false
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	})
+
+	_, _, err := client.Devices.Power(ctx, "dev-1", "../../tenants", nil)
+
+	assert.EqualError(t, err, `failed to change device power state: unknown action "../../tenants"`)
+	assert.False(t, requested, "request with unknown action must not be sent")
+}
+
+func TestPowerAction_PoweredOn(t *testing.T) {
+	assert.True(t, PowerActionStart.PoweredOn())
+	assert.True(t, PowerActionReboot.PoweredOn())
+	assert.True(t, PowerActionReset.PoweredOn())
+	assert.True(t, PowerActionResume.PoweredOn())
+	assert.False(t, PowerActionStop.PoweredOn())
+	assert.False(t, PowerActionShutdown.PoweredOn())
+	assert.False(t, PowerActionSuspend.PoweredOn())
+}
@@ -1,4 +1,8 @@
 /*
 Package xelon provides a client for using the Xelon API.
+
+Every service of Client implements an interface named after the service, e.g.
+DevicesService implements DevicesAPI. Code depending on these interfaces instead
+of the concrete services can be tested with the mocks in package xelonmock.
 */
 package xelon
@@ -14,6 +14,23 @@ const dnsBasePath = "dns"
 // DomainsService handles communication with DNS methods of the Xelon API.
 type DomainsService service
 
+// DomainsAPI is the interface implemented by DomainsService.
+type DomainsAPI interface {
+	ListZones(ctx context.Context, opts *DNSZoneListOptions) ([]DNSZone, *Response, error)
+	AllZones(ctx context.Context, opts *DNSZoneListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[DNSZone, *Response], func() error)
+	GetZone(ctx context.Context, dnsZoneID string) (*DNSZone, *Response, error)
+	CreateZone(ctx context.Context, createRequest *DNSZoneCreateRequest) (*DNSZone, *Response, error)
+	DeleteZone(ctx context.Context, dnsZoneID string) (*Response, error)
+	ListRecords(ctx context.Context, dnsZoneID string) ([]DNSRecord, *Response, error)
+	CreateRecord(ctx context.Context, dnsZoneID string, createRequest *DNSRecordCreateRequest) (*Response, error)
+	UpdateRecord(ctx context.Context, dnsZoneID string, dnsRecordID int, updateRequest *DNSRecordUpdateRequest) (*Response, error)
+	DeleteRecord(ctx context.Context, dnsZoneID string, dnsRecordID int) (*Response, error)
+	GetSOA(ctx context.Context, dnsZoneID string) (*DNSSOA, *Response, error)
+	UpdateSOA(ctx context.Context, dnsZoneID string, updateRequest *DNSSOAUpdateRequest) (*Response, error)
+}
+
+var _ DomainsAPI = (*DomainsService)(nil)
+
 // DNSZone represents a Xelon DNS zone.
 type DNSZone struct {
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
@@ -26,9 +43,24 @@ type DNSZoneCreateRequest struct {
 	Domain string `json:"domain"`
 }
 
+// DNSZoneSort is a field DNS zones can be sorted by, use SortDesc for descending order.
+type DNSZoneSort string
+
+const (
+	DNSZoneSortName      DNSZoneSort = "name"
+	DNSZoneSortCreatedAt DNSZoneSort = "createdAt"
+)
+
+func (DNSZoneSort) sortFields() []string {
+	return sortFieldNames(
+		DNSZoneSortName,
+		DNSZoneSortCreatedAt,
+	)
+}
+
 type DNSZoneListOptions struct {
-	Search string `url:"search,omitempty"`
-	Sort   string `url:"sort,omitempty"`
+	Search string      `url:"search,omitempty"`
+	Sort   DNSZoneSort `url:"sort,omitempty"`
 
 	ListOptions
 }
@@ -47,7 +79,7 @@ func (v DNSZone) String() string { return Stringify(v) }
 
 // ListZones lists DNS zones.
 func (s *DomainsService) ListZones(ctx context.Context, opts *DNSZoneListOptions) ([]DNSZone, *Response, error) {
-	path, err := addOptions(dnsBasePath, opts)
+	path, err := s.client.addListOptions(dnsBasePath, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -58,7 +90,7 @@ func (s *DomainsService) ListZones(ctx context.Context, opts *DNSZoneListOptions
 	}
 
 	root := new(dnsZonesRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "DomainsService.ListZones"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -72,8 +104,8 @@ func (s *DomainsService) ListZones(ctx context.Context, opts *DNSZoneListOptions
 // AllZones returns an iterator over all DNS zones.
 //
 // The returned iterator can be used in a for...range loop.
-func (s *DomainsService) AllZones(ctx context.Context, opts *ListOptions) (iter.Seq2[DNSZone, *Response], func() error) {
-	return newPaginator[DNSZone](ctx, s.client, dnsBasePath, opts)
+func (s *DomainsService) AllZones(ctx context.Context, opts *DNSZoneListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[DNSZone, *Response], func() error) {
+	return newPaginator[DNSZone](withOperation(ctx, "DomainsService.AllZones"), s.client, dnsBasePath, opts, paginatorOpts...)
 }
 
 // GetZone gets a DNS zone by id.
@@ -89,7 +121,7 @@ func (s *DomainsService) GetZone(ctx context.Context, dnsZoneID string) (*DNSZon
 	}
 
 	dnsZone := new(DNSZone)
-	resp, err := s.client.Do(ctx, req, dnsZone)
+	resp, err := s.client.Do(withOperation(ctx, "DomainsService.GetZone"), req, dnsZone)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -109,7 +141,7 @@ func (s *DomainsService) CreateZone(ctx context.Context, createRequest *DNSZoneC
 	}
 
 	root := new(dnsZoneRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "DomainsService.CreateZone"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -132,7 +164,7 @@ func (s *DomainsService) DeleteZone(ctx context.Context, dnsZoneID string) (*Res
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "DomainsService.DeleteZone"), req, nil)
 }
 
 // DNSRecordType represents a supported DNS record type.
@@ -232,7 +264,7 @@ func (s *DomainsService) ListRecords(ctx context.Context, dnsZoneID string) ([]D
 	}
 
 	root := new(dnsRecordsRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "DomainsService.ListRecords"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -256,7 +288,7 @@ func (s *DomainsService) CreateRecord(ctx context.Context, dnsZoneID string, cre
 	}
 
 	root := new(dnsRecordRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "DomainsService.CreateRecord"), req, root)
 	if err != nil {
 		return resp, err
 	}
@@ -283,7 +315,7 @@ func (s *DomainsService) UpdateRecord(ctx context.Context, dnsZoneID string, dns
 	}
 
 	root := new(dnsRecordRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "DomainsService.UpdateRecord"), req, root)
 	if err != nil {
 		return resp, err
 	}
@@ -306,7 +338,7 @@ func (s *DomainsService) DeleteRecord(ctx context.Context, dnsZoneID string, dns
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "DomainsService.DeleteRecord"), req, nil)
 }
 
 // DNSSOA represents a Xelon DNS SOA record.
@@ -348,7 +380,7 @@ func (s *DomainsService) GetSOA(ctx context.Context, dnsZoneID string) (*DNSSOA,
 	}
 
 	dnsSOA := new(DNSSOA)
-	resp, err := s.client.Do(ctx, req, dnsSOA)
+	resp, err := s.client.Do(withOperation(ctx, "DomainsService.GetSOA"), req, dnsSOA)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -372,7 +404,7 @@ func (s *DomainsService) UpdateSOA(ctx context.Context, dnsZoneID string, update
 	}
 
 	root := new(dnsSOARoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "DomainsService.UpdateSOA"), req, root)
 	if err != nil {
 		return resp, err
 	}
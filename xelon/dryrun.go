@@ -0,0 +1,181 @@
+package xelon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon/internal/redact"
+)
+
+// WithDryRun configures Client to capture mutating requests (POST, PUT, PATCH and
+// DELETE) in a Plan instead of sending them. Other requests are sent as usual.
+//
+// Service methods return empty results for captured requests and Response.DryRun
+// is set. The captured requests are available with Client.Plan.
+func WithDryRun(enabled bool) ClientOption {
+	return func(client *Client) {
+		if enabled {
+			client.dryRun = new(planRecorder)
+		} else {
+			client.dryRun = nil
+		}
+	}
+}
+
+// PlannedChange is a mutating request captured in dry-run mode.
+type PlannedChange struct {
+	// Operation is the service method which issued the request, e.g. "DevicesService.Create".
+	Operation string `json:"operation,omitempty"`
+
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+
+	// Path is the path of the request relative to the base URL including the query.
+	Path string `json:"path"`
+
+	// Body is the decoded JSON body of the request with secrets like passwords redacted.
+	Body any `json:"body,omitempty"`
+}
+
+func (v PlannedChange) String() string { return Stringify(v) }
+
+// Plan is the list of changes captured in dry-run mode, in the order they were requested.
+// It can be printed as human-readable diff or marshaled to JSON.
+type Plan struct {
+	Changes []PlannedChange `json:"changes"`
+}
+
+// String renders the plan like a diff: created resources are prefixed with "+",
+// updated ones with "~" and deleted ones with "-".
+func (p *Plan) String() string {
+	if p == nil || len(p.Changes) == 0 {
+		return "No changes.\n"
+	}
+
+	var b strings.Builder
+	var creates, updates, deletes int
+	for _, change := range p.Changes {
+		symbol := "~"
+		switch change.Method {
+		case http.MethodPost:
+			symbol = "+"
+			creates++
+		case http.MethodDelete:
+			symbol = "-"
+			deletes++
+		default:
+			updates++
+		}
+
+		fmt.Fprintf(&b, "%v %v %v", symbol, change.Method, change.Path)
+		if change.Operation != "" {
+			fmt.Fprintf(&b, " (%v)", change.Operation)
+		}
+		b.WriteString("\n")
+		if change.Body != nil {
+			body, _ := json.MarshalIndent(change.Body, "", "  ")
+			for line := range strings.Lines(string(body)) {
+				fmt.Fprintf(&b, "%v     %v\n", symbol, strings.TrimSuffix(line, "\n"))
+			}
+		}
+	}
+	fmt.Fprintf(&b, "\nPlan: %d to create, %d to update, %d to delete.\n", creates, updates, deletes)
+	return b.String()
+}
+
+// planRecorder collects the changes captured in dry-run mode.
+type planRecorder struct {
+	mu      sync.Mutex
+	changes []PlannedChange
+}
+
+// Plan returns a snapshot of the changes captured in dry-run mode, see WithDryRun.
+// The plan is empty if dry-run mode is disabled.
+func (c *Client) Plan() *Plan {
+	plan := &Plan{Changes: []PlannedChange{}}
+	if c.dryRun == nil {
+		return plan
+	}
+
+	c.dryRun.mu.Lock()
+	defer c.dryRun.mu.Unlock()
+	plan.Changes = append(plan.Changes, c.dryRun.changes...)
+	return plan
+}
+
+// isMutating reports whether req changes resources and is captured in dry-run mode.
+func isMutating(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// capture records the request of call in the plan and returns a synthetic response.
+// The value pointed to by v is filled with an empty result.
+func (c *Client) capture(call *Call, v any) (*Response, error) {
+	req := call.Request
+	change := PlannedChange{
+		Operation: call.Operation,
+		Method:    req.Method,
+		Path:      strings.TrimPrefix(req.URL.RequestURI(), c.baseURL.Path),
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if body = bytes.TrimSpace(redact.JSON(body)); len(body) > 0 {
+			decoder := json.NewDecoder(bytes.NewReader(body))
+			decoder.UseNumber()
+			if err := decoder.Decode(&change.Body); err != nil {
+				change.Body = string(body)
+			}
+		}
+	}
+
+	c.dryRun.mu.Lock()
+	c.dryRun.changes = append(c.dryRun.changes, change)
+	c.dryRun.mu.Unlock()
+
+	emptyResult(v)
+	return &Response{
+		Response: &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     make(http.Header),
+			Body:       http.NoBody,
+			Request:    req,
+		},
+		DryRun: true,
+	}, nil
+}
+
+// emptyResult allocates the nil data field of the response root pointed to by v, so
+// service methods return empty results instead of failing on missing data.
+func emptyResult(v any) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return
+	}
+	root := rv.Elem()
+	for i := range root.NumField() {
+		field := root.Field(i)
+		name, _, _ := strings.Cut(root.Type().Field(i).Tag.Get("json"), ",")
+		if name == "data" && field.CanSet() && field.Kind() == reflect.Pointer && field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package xelon
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun_capturesMutatingRequests(t *testing.T) {
+	setup()
+	defer teardown()
+	WithDryRun(true)(client)
+
+	mux.HandleFunc("GET /devices/dev-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"identifier":"dev-1","hostName":"web-1"}`))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("%v %v must not be sent in dry-run mode", r.Method, r.URL.Path)
+	})
+
+	device, resp, err := client.Devices.Get(ctx, "dev-1")
+	require.NoError(t, err)
+	assert.False(t, resp.DryRun)
+	assert.Equal(t, "web-1", device.HostName)
+
+	created, resp, err := client.Devices.Create(ctx, &DeviceCreateRequest{HostName: "web-2", CPUCores: 2, Password: "secret"})
+	require.NoError(t, err)
+	assert.True(t, resp.DryRun)
+	assert.Equal(t, &Device{}, created)
+
+	_, _, err = client.Devices.Update(ctx, "dev-1", &DeviceUpdateRequest{DisplayName: "web"})
+	require.NoError(t, err)
+	_, err = client.Devices.Delete(ctx, "dev-1")
+	require.NoError(t, err)
+
+	plan := client.Plan()
+	require.Len(t, plan.Changes, 3)
+	assert.Equal(t, PlannedChange{Operation: "DevicesService.Update", Method: http.MethodPut, Path: "devices/dev-1", Body: map[string]any{"displayName": "web"}}, plan.Changes[1])
+	assert.Equal(t, PlannedChange{Operation: "DevicesService.Delete", Method: http.MethodDelete, Path: "devices/dev-1"}, plan.Changes[2])
+	assert.Equal(t, "REDACTED", plan.Changes[0].Body.(map[string]any)["password"])
+}
+
+func TestDryRun_emptyResults(t *testing.T) {
+	setup()
+	defer teardown()
+	WithDryRun(true)(client)
+
+	user, _, err := client.ObjectStorages.CreateUser(ctx, &ObjectStorageUserCreateRequest{Name: "backup"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &ObjectStorageUser{}, user)
+}
+
+func TestDryRun_disabled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("DELETE /devices/dev-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Devices.Delete(ctx, "dev-1")
+
+	assert.NoError(t, err)
+	assert.False(t, resp.DryRun)
+	assert.Empty(t, client.Plan().Changes)
+}
+
+func TestPlan_String(t *testing.T) {
+	plan := &Plan{Changes: []PlannedChange{
+		{Operation: "DevicesService.Create", Method: http.MethodPost, Path: "devices", Body: map[string]any{"cpu": json.Number("2"), "hostName": "web-2"}},
+		{Operation: "DevicesService.Update", Method: http.MethodPut, Path: "devices/dev-1", Body: map[string]any{"displayName": "web"}},
+		{Method: http.MethodDelete, Path: "devices/dev-1"},
+	}}
+
+	expected := `+ POST devices (DevicesService.Create)
++     {
++       "cpu": 2,
++       "hostName": "web-2"
++     }
+~ PUT devices/dev-1 (DevicesService.Update)
+~     {
+~       "displayName": "web"
+~     }
+- DELETE devices/dev-1
+
+Plan: 1 to create, 1 to update, 1 to delete.
+`
+	assert.Equal(t, expected, plan.String())
+	assert.Equal(t, "No changes.\n", (&Plan{}).String())
+}
+
+func TestPlan_JSON(t *testing.T) {
+	plan := &Plan{Changes: []PlannedChange{
+		{Operation: "SSHKeysService.Delete", Method: http.MethodDelete, Path: "ssh-keys/key-1"},
+	}}
+
+	data, err := json.Marshal(plan)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"changes":[{"operation":"SSHKeysService.Delete","method":"DELETE","path":"ssh-keys/key-1"}]}`, string(data))
+}
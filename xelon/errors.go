@@ -1,8 +1,14 @@
 package xelon
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -11,15 +17,126 @@ var (
 	ErrEmptyPayloadNotAllowed = errors.New("payload cannot be empty")
 )
 
+// Sentinel errors matching an ErrorResponse by its HTTP status code via errors.Is.
+var (
+	ErrUnauthorized = errors.New("unauthorized")        // 401 Unauthorized
+	ErrForbidden    = errors.New("forbidden")           // 403 Forbidden
+	ErrNotFound     = errors.New("resource not found")  // 404 Not Found
+	ErrConflict     = errors.New("conflict")            // 409 Conflict
+	ErrValidation   = errors.New("validation failed")   // 422 Unprocessable Entity
+	ErrRateLimited  = errors.New("rate limit exceeded") // 429 Too Many Requests
+)
+
 type ErrorResponse struct {
 	Response     *Response // HTTP response that caused this error.
 	ErrorElement ErrorElement
 }
 
 type ErrorElement struct {
-	Error       string         `json:"error,omitempty"`
-	Message     string         `json:"message,omitempty"`
-	Validations map[string]any `json:"errors,omitempty"`
+	Error       string      `json:"error,omitempty"`
+	Message     string      `json:"message,omitempty"`
+	Validations FieldErrors `json:"errors,omitempty"`
+}
+
+// FieldError represents validation messages for a single field of the request payload.
+type FieldError struct {
+	// Field is the JSON path of the invalid field, e.g. "hostName" or "networks.0.networkId".
+	Field    string
+	Messages []string
+}
+
+// FieldErrors is a list of field validation errors sorted by field name.
+type FieldErrors []FieldError
+
+// UnmarshalJSON decodes the Laravel-style "errors" object, which maps field names
+// to a list of messages (or a single message), into a list sorted by field name.
+func (e *FieldErrors) UnmarshalJSON(data []byte) error {
+	// an empty PHP array is encoded as [] instead of {}
+	if trimmed := bytes.TrimSpace(data); string(trimmed) == "null" || string(trimmed) == "[]" {
+		*e = nil
+		return nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	fieldErrors := make(FieldErrors, 0, len(raw))
+	for field, value := range raw {
+		var messages []string
+		if err := json.Unmarshal(value, &messages); err != nil {
+			var message string
+			if err := json.Unmarshal(value, &message); err != nil {
+				return fmt.Errorf("errors.%v must be string or []string: %w", field, err)
+			}
+			messages = []string{message}
+		}
+		fieldErrors = append(fieldErrors, FieldError{Field: field, Messages: messages})
+	}
+	slices.SortFunc(fieldErrors, func(a, b FieldError) int { return strings.Compare(a.Field, b.Field) })
+
+	*e = fieldErrors
+	return nil
+}
+
+// StructField maps the JSON path of the field error back to the Go struct field of
+// request, the payload that caused the error. For example, "hostName" is resolved
+// to "DeviceCreateRequest.HostName" and "networks.0.networkId" to
+// "DeviceCreateRequest.Networks[0].NetworkID".
+func (e FieldError) StructField(request any) (string, bool) {
+	t := reflect.TypeOf(request)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	path := t.Name()
+	for segment := range strings.SplitSeq(e.Field, ".") {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			if _, err := strconv.Atoi(segment); err != nil {
+				return "", false
+			}
+			path += "[" + segment + "]"
+			t = t.Elem()
+		case reflect.Struct:
+			field, ok := fieldByJSONName(t, segment)
+			if !ok {
+				return "", false
+			}
+			path += "." + field.Name
+			t = field.Type
+		default:
+			return "", false
+		}
+	}
+
+	return path, true
+}
+
+// fieldByJSONName finds the field of struct type t (including promoted fields
+// of embedded structs) whose JSON name is name.
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		tagName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if tagName == "-" {
+			continue
+		}
+		if tagName == name || (tagName == "" && strings.EqualFold(field.Name, name)) {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
 }
 
 func (e ErrorElement) String() string {
@@ -34,8 +151,8 @@ func (e ErrorElement) String() string {
 	}
 
 	var validations []string
-	for k, v := range e.Validations {
-		validations = append(validations, fmt.Sprintf("%v - %v", k, v))
+	for _, v := range e.Validations {
+		validations = append(validations, fmt.Sprintf("%v - %v", v.Field, v.Messages))
 	}
 	if len(validations) > 0 {
 		elements = append(elements, fmt.Sprintf("validations: (%v)", strings.Join(validations, ", ")))
@@ -45,6 +162,51 @@ func (e ErrorElement) String() string {
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d (%+v)",
+	message := fmt.Sprintf("%v %v: %d (%+v)",
 		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL), r.Response.StatusCode, r.ErrorElement)
+	if r.Response.RequestID != "" {
+		message += fmt.Sprintf(" [request id: %v]", r.Response.RequestID)
+	}
+	return message
+}
+
+// Unwrap returns the sentinel error matching the HTTP status code of the response,
+// so that errors.Is(err, ErrNotFound) reports whether the resource was not found.
+func (r *ErrorResponse) Unwrap() error {
+	if r.Response == nil || r.Response.Response == nil {
+		return nil
+	}
+	switch r.Response.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusUnprocessableEntity:
+		return ErrValidation
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	}
+	return nil
 }
+
+// IsUnauthorized reports whether err is caused by missing or invalid credentials.
+func IsUnauthorized(err error) bool { return errors.Is(err, ErrUnauthorized) }
+
+// IsForbidden reports whether err is caused by insufficient permissions.
+func IsForbidden(err error) bool { return errors.Is(err, ErrForbidden) }
+
+// IsNotFound reports whether err is caused by a resource which does not exist.
+func IsNotFound(err error) bool { return errors.Is(err, ErrNotFound) }
+
+// IsConflict reports whether err is caused by a conflict with the current state of a resource.
+func IsConflict(err error) bool { return errors.Is(err, ErrConflict) }
+
+// IsValidation reports whether err is caused by an invalid request payload.
+func IsValidation(err error) bool { return errors.Is(err, ErrValidation) }
+
+// IsRateLimited reports whether err is caused by exceeding the API rate limit.
+func IsRateLimited(err error) bool { return errors.Is(err, ErrRateLimited) }
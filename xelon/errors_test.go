@@ -2,6 +2,7 @@ package xelon
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"testing"
@@ -106,3 +107,171 @@ func TestErrors_Error(t *testing.T) {
 
 	assert.Equal(t, expectedErrorResponse, actualErrorResponse)
 }
+
+func TestErrors_Error_withRequestID(t *testing.T) {
+	errorResponse := &ErrorResponse{
+		ErrorElement: ErrorElement{
+			Error: "Virtual machine is not found",
+		},
+		Response: &Response{
+			Response: &http.Response{
+				StatusCode: http.StatusNotFound,
+				Request: &http.Request{
+					Method: http.MethodGet,
+					URL:    &url.URL{Scheme: "http", Host: "localhost", Path: "api/testing"},
+				},
+			},
+			RequestID: "abc-123",
+		},
+	}
+
+	actualErrorResponse := errorResponse.Error()
+	expectedErrorResponse := "GET http://localhost/api/testing: 404 (error: Virtual machine is not found) [request id: abc-123]"
+
+	assert.Equal(t, expectedErrorResponse, actualErrorResponse)
+}
+
+func TestErrors_Is(t *testing.T) {
+	type testCase struct {
+		statusCode int
+		expected   error
+		check      func(error) bool
+	}
+	tests := map[string]testCase{
+		"unauthorized": {statusCode: http.StatusUnauthorized, expected: ErrUnauthorized, check: IsUnauthorized},
+		"forbidden":    {statusCode: http.StatusForbidden, expected: ErrForbidden, check: IsForbidden},
+		"not found":    {statusCode: http.StatusNotFound, expected: ErrNotFound, check: IsNotFound},
+		"conflict":     {statusCode: http.StatusConflict, expected: ErrConflict, check: IsConflict},
+		"validation":   {statusCode: http.StatusUnprocessableEntity, expected: ErrValidation, check: IsValidation},
+		"rate limited": {statusCode: http.StatusTooManyRequests, expected: ErrRateLimited, check: IsRateLimited},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var err error = &ErrorResponse{
+				Response: &Response{Response: &http.Response{StatusCode: test.statusCode}},
+			}
+			wrapped := fmt.Errorf("failed to get device: %w", err)
+
+			assert.ErrorIs(t, wrapped, test.expected)
+			assert.True(t, test.check(wrapped))
+			assert.NotErrorIs(t, err, ErrEmptyArgument)
+		})
+	}
+}
+
+func TestErrors_Is_unknownStatusCode(t *testing.T) {
+	err := &ErrorResponse{
+		Response: &Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}},
+	}
+
+	assert.Nil(t, err.Unwrap())
+	assert.False(t, IsNotFound(err))
+	assert.False(t, IsNotFound(nil))
+}
+
+func TestErrors_IsNotFound_fromClient(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("GET /devices/unknown", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"Virtual machine is not found"}`))
+	})
+
+	_, _, err := client.Devices.Get(ctx, "unknown")
+
+	assert.True(t, IsNotFound(err))
+}
+
+func TestErrors_String_validationsAreSorted(t *testing.T) {
+	validationResponse := []byte(`
+{
+  "message": "The given data was invalid.",
+  "errors": {
+    "password": ["The password must be at least 8 characters."],
+    "cpu": "The cpu must be at least 1.",
+    "hostName": ["The host name has already been taken.", "The host name format is invalid."]
+  }
+}`)
+
+	var errorElement ErrorElement
+	err := json.Unmarshal(validationResponse, &errorElement)
+
+	assert.NoError(t, err)
+	assert.Equal(t, FieldErrors{
+		{Field: "cpu", Messages: []string{"The cpu must be at least 1."}},
+		{Field: "hostName", Messages: []string{"The host name has already been taken.", "The host name format is invalid."}},
+		{Field: "password", Messages: []string{"The password must be at least 8 characters."}},
+	}, errorElement.Validations)
+	assert.Equal(t,
+		"details: The given data was invalid., validations: (cpu - [The cpu must be at least 1.], "+
+			"hostName - [The host name has already been taken. The host name format is invalid.], "+
+			"password - [The password must be at least 8 characters.])",
+		errorElement.String(),
+	)
+}
+
+func TestErrors_String_emptyValidationsArray(t *testing.T) {
+	var errorElement ErrorElement
+	err := json.Unmarshal([]byte(`{"message":"Server Error","errors":[]}`), &errorElement)
+
+	assert.NoError(t, err)
+	assert.Empty(t, errorElement.Validations)
+}
+
+func TestErrors_FieldError_StructField(t *testing.T) {
+	type testCase struct {
+		field         string
+		request       any
+		expected      string
+		expectedFound bool
+	}
+	tests := map[string]testCase{
+		"top level field": {
+			field:         "hostName",
+			request:       &DeviceCreateRequest{},
+			expected:      "DeviceCreateRequest.HostName",
+			expectedFound: true,
+		},
+		"nested slice field": {
+			field:         "networks.0.networkId",
+			request:       DeviceCreateRequest{},
+			expected:      "DeviceCreateRequest.Networks[0].NetworkID",
+			expectedFound: true,
+		},
+		"nested pointer field": {
+			field:         "cloudInit.userData",
+			request:       &DeviceCreateRequest{},
+			expected:      "DeviceCreateRequest.CloudInit.UserData",
+			expectedFound: true,
+		},
+		"promoted field of embedded struct": {
+			field:         "sshKey",
+			request:       &SSHKeyCreateRequest{},
+			expected:      "SSHKeyCreateRequest.PublicKey",
+			expectedFound: true,
+		},
+		"unknown field": {
+			field:   "unknown",
+			request: &DeviceCreateRequest{},
+		},
+		"invalid index": {
+			field:   "networks.first.networkId",
+			request: &DeviceCreateRequest{},
+		},
+		"non struct request": {
+			field:   "hostName",
+			request: "hostName",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, found := FieldError{Field: test.field}.StructField(test.request)
+
+			assert.Equal(t, test.expectedFound, found)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -14,6 +15,21 @@ const firewallBasePath = "firewalls"
 // FirewallsService handles communication with the firewalls related methods of the Xelon REST API.
 type FirewallsService service
 
+// FirewallsAPI is the interface implemented by FirewallsService.
+type FirewallsAPI interface {
+	List(ctx context.Context, opts *FirewallListOptions) ([]Firewall, *Response, error)
+	All(ctx context.Context, opts *FirewallListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Firewall, *Response], func() error)
+	Get(ctx context.Context, firewallID string) (*Firewall, *Response, error)
+	Create(ctx context.Context, createRequest *FirewallCreateRequest) (*Firewall, *Response, error)
+	Update(ctx context.Context, firewallID string, updateRequest *FirewallUpdateRequest) (*Firewall, *Response, error)
+	Delete(ctx context.Context, firewallID string) (*Response, error)
+	CreateForwardingRule(ctx context.Context, firewallID string, createRequest *FirewallCreateForwardingRuleRequest) (*FirewallForwardingRule, *Response, error)
+	UpdateForwardingRule(ctx context.Context, firewallID, forwardingRuleID string, updateRequest *FirewallUpdateForwardingRuleRequest) (*FirewallForwardingRule, *Response, error)
+	DeleteForwardingRule(ctx context.Context, firewallID, forwardingRuleID string) (*Response, error)
+}
+
+var _ FirewallsAPI = (*FirewallsService)(nil)
+
 // Firewall represents a Xelon firewall.
 type Firewall struct {
 	Cloud             *Cloud                   `json:"cloud,omitempty"`
@@ -141,10 +157,32 @@ type FirewallUpdateForwardingRuleRequest struct {
 	FirewallForwardingRule
 }
 
+// FirewallSort is a field firewalls can be sorted by, use SortDesc for descending order.
+type FirewallSort string
+
+const (
+	FirewallSortName      FirewallSort = "name"
+	FirewallSortState     FirewallSort = "state"
+	FirewallSortCreatedAt FirewallSort = "createdAt"
+)
+
+func (FirewallSort) sortFields() []string {
+	return sortFieldNames(
+		FirewallSortName,
+		FirewallSortState,
+		FirewallSortCreatedAt,
+	)
+}
+
 // FirewallListOptions specifies the optional parameters to the FirewallsService.List.
 type FirewallListOptions struct {
-	Sort   string `url:"sort,omitempty"`
-	Search string `url:"search,omitempty"`
+	Sort   FirewallSort `url:"sort,omitempty"`
+	Search string       `url:"search,omitempty"`
+
+	// CloudID, TenantID and State filter the list by cloud, tenant and state.
+	CloudID  string `url:"cloudIdentifier,omitempty"`
+	TenantID string `url:"tenantIdentifier,omitempty"`
+	State    *int   `url:"state,omitempty"`
 
 	ListOptions
 }
@@ -168,7 +206,7 @@ func (v Firewall) String() string { return Stringify(v) }
 
 // List provides a list of all firewalls.
 func (s *FirewallsService) List(ctx context.Context, opts *FirewallListOptions) ([]Firewall, *Response, error) {
-	path, err := addOptions(firewallBasePath, opts)
+	path, err := s.client.addListOptions(firewallBasePath, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -179,7 +217,7 @@ func (s *FirewallsService) List(ctx context.Context, opts *FirewallListOptions)
 	}
 
 	root := new(firewallsRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "FirewallsService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -190,6 +228,13 @@ func (s *FirewallsService) List(ctx context.Context, opts *FirewallListOptions)
 	return root.Firewalls, resp, nil
 }
 
+// All returns an iterator to paginate over all firewalls.
+//
+// The return iterator can be used in a for...range loop to easily process all firewalls.
+func (s *FirewallsService) All(ctx context.Context, opts *FirewallListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Firewall, *Response], func() error) {
+	return newPaginator[Firewall](withOperation(ctx, "FirewallsService.All"), s.client, firewallBasePath, opts, paginatorOpts...)
+}
+
 // Get provides detailed information for firewall identified by id.
 func (s *FirewallsService) Get(ctx context.Context, firewallID string) (*Firewall, *Response, error) {
 	if firewallID == "" {
@@ -203,7 +248,7 @@ func (s *FirewallsService) Get(ctx context.Context, firewallID string) (*Firewal
 	}
 
 	firewall := new(Firewall)
-	resp, err := s.client.Do(ctx, req, firewall)
+	resp, err := s.client.Do(withOperation(ctx, "FirewallsService.Get"), req, firewall)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -223,7 +268,7 @@ func (s *FirewallsService) Create(ctx context.Context, createRequest *FirewallCr
 	}
 
 	firewallRoot := new(firewallRoot)
-	resp, err := s.client.Do(ctx, req, firewallRoot)
+	resp, err := s.client.Do(withOperation(ctx, "FirewallsService.Create"), req, firewallRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -247,7 +292,7 @@ func (s *FirewallsService) Update(ctx context.Context, firewallID string, update
 	}
 
 	firewallRoot := new(firewallRoot)
-	resp, err := s.client.Do(ctx, req, firewallRoot)
+	resp, err := s.client.Do(withOperation(ctx, "FirewallsService.Update"), req, firewallRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -267,7 +312,7 @@ func (s *FirewallsService) Delete(ctx context.Context, firewallID string) (*Resp
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "FirewallsService.Delete"), req, nil)
 }
 
 // CreateForwardingRule makes a new forwarding rule.
@@ -286,7 +331,7 @@ func (s *FirewallsService) CreateForwardingRule(ctx context.Context, firewallID
 	}
 
 	firewallForwardingRuleRoot := new(firewallForwardingRuleRoot)
-	resp, err := s.client.Do(ctx, req, firewallForwardingRuleRoot)
+	resp, err := s.client.Do(withOperation(ctx, "FirewallsService.CreateForwardingRule"), req, firewallForwardingRuleRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -313,7 +358,7 @@ func (s *FirewallsService) UpdateForwardingRule(ctx context.Context, firewallID,
 	}
 
 	firewallForwardingRuleRoot := new(firewallForwardingRuleRoot)
-	resp, err := s.client.Do(ctx, req, firewallForwardingRuleRoot)
+	resp, err := s.client.Do(withOperation(ctx, "FirewallsService.UpdateForwardingRule"), req, firewallForwardingRuleRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -336,5 +381,5 @@ func (s *FirewallsService) DeleteForwardingRule(ctx context.Context, firewallID,
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "FirewallsService.DeleteForwardingRule"), req, nil)
 }
@@ -0,0 +1,135 @@
+package xelon
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// IdempotencyKeyHeader is the HTTP header carrying the idempotency key of a POST request.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// ErrIdempotencyKeyUsed is returned for a POST request made with a context whose
+// idempotency key was already used by another request, see ContextWithIdempotencyKey.
+var ErrIdempotencyKeyUsed = errors.New("idempotency key already used by another request")
+
+// WithIdempotencyKeys configures Client to send a random idempotency key with every
+// POST request which has no key set with ContextWithIdempotencyKey.
+//
+// Sending a key does not make a request eligible for retries, which have to be enabled
+// with RetryPolicy.RetryWithIdempotencyKey. For endpoints where the API does not honor
+// the keys, use methods like DevicesService.CreateOrFind to avoid duplicates.
+func WithIdempotencyKeys(enabled bool) ClientOption {
+	return func(client *Client) {
+		client.idempotencyKeys = enabled
+	}
+}
+
+type idempotencyKeyContextKey struct{}
+
+// idempotencyKeyScope is the idempotency key of a context, which is used by one request.
+type idempotencyKeyScope struct {
+	key  string
+	used atomic.Bool
+}
+
+// claim marks the key as used and reports whether it was unused before.
+func (s *idempotencyKeyScope) claim() bool {
+	return s.used.CompareAndSwap(false, true)
+}
+
+// ContextWithIdempotencyKey returns a copy of ctx carrying key, which is sent as
+// idempotency key with the first POST request made with the returned context, including
+// all retries of that request. Further POST requests made with the context fail with
+// ErrIdempotencyKeyUsed, because a different operation with the same key would be
+// answered with the response of the first one. Use a new context for every operation.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, &idempotencyKeyScope{key: key})
+}
+
+// idempotencyKey returns the idempotency key for req, which is either the key of ctx
+// or a random key if automatic keys are enabled. Only POST requests get a key.
+func (c *Client) idempotencyKey(ctx context.Context, req *http.Request) (string, error) {
+	if req.Method != http.MethodPost {
+		return "", nil
+	}
+	if scope, _ := ctx.Value(idempotencyKeyContextKey{}).(*idempotencyKeyScope); scope != nil && scope.key != "" {
+		if !scope.claim() {
+			return "", fmt.Errorf("idempotency key %q: %w", scope.key, ErrIdempotencyKeyUsed)
+		}
+		return scope.key, nil
+	}
+	if c.idempotencyKeys {
+		return newIdempotencyKey(), nil
+	}
+	return "", nil
+}
+
+// newIdempotencyKey returns a random UUID (version 4).
+func newIdempotencyKey() string {
+	var uuid [16]byte
+	_, _ = rand.Read(uuid[:])
+	uuid[6] = (uuid[6] & 0x0f) | 0x40 // version 4
+	uuid[8] = (uuid[8] & 0x3f) | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:])
+}
+
+type noRetryContextKey struct{}
+
+// withoutRetries returns a copy of ctx for which the RetryPolicy of Client is ignored.
+func withoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryContextKey{}, true)
+}
+
+// createOrFind calls create and retries it according to the RetryPolicy of client, it
+// is not retried without a policy. If the outcome of create is unknown (e.g. because of a timeout or a server error) or
+// the resource already exists, find looks up the resource by its unique name before
+// create is retried. All attempts share the same idempotency key, which is the key of
+// ctx if it has one.
+func createOrFind[T any](ctx context.Context, client *Client, create, find func(ctx context.Context) (*T, *Response, error)) (*T, *Response, error) {
+	key := newIdempotencyKey()
+	if scope, _ := ctx.Value(idempotencyKeyContextKey{}).(*idempotencyKeyScope); scope != nil && scope.key != "" {
+		if !scope.claim() {
+			return nil, nil, fmt.Errorf("idempotency key %q: %w", scope.key, ErrIdempotencyKeyUsed)
+		}
+		key = scope.key
+	}
+	policy := client.retryPolicy
+
+	for attempt := 1; ; attempt++ {
+		// the request must not be retried before the resource was looked up
+		v, resp, err := create(withoutRetries(ContextWithIdempotencyKey(ctx, key)))
+		if err == nil {
+			return v, resp, nil
+		}
+
+		unknownOutcome := IsRetryableNetworkError(err) || (resp != nil && resp.StatusCode >= http.StatusInternalServerError)
+		if !unknownOutcome && !IsConflict(err) {
+			return nil, resp, err
+		}
+
+		found, findResp, findErr := find(ctx)
+		if findErr != nil {
+			return nil, resp, errors.Join(err, fmt.Errorf("failed to look up resource: %w", findErr))
+		}
+		if found != nil {
+			return found, findResp, nil
+		}
+		if !unknownOutcome || policy == nil || attempt >= policy.MaxAttempts {
+			return nil, resp, err
+		}
+
+		wait, _ := policy.backoff(attempt, nil)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package xelon
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestIdempotencyKeys_automatic(t *testing.T) {
+	setup()
+	defer teardown()
+	WithIdempotencyKeys(true)(client)
+
+	var keys []string
+	mux.HandleFunc("POST /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		_, _ = w.Write([]byte(`{"data":{"identifier":"key-1"}}`))
+	})
+	mux.HandleFunc("GET /ssh-keys/key-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get(IdempotencyKeyHeader))
+		_, _ = w.Write([]byte(`{"identifier":"key-1"}`))
+	})
+
+	_, _, err := client.SSHKeys.Create(ctx, &SSHKeyCreateRequest{SSHKey: SSHKey{Name: "test"}})
+	assert.NoError(t, err)
+	_, _, err = client.SSHKeys.Create(ctx, &SSHKeyCreateRequest{SSHKey: SSHKey{Name: "test"}})
+	assert.NoError(t, err)
+	_, _, err = client.SSHKeys.Get(ctx, "key-1")
+	assert.NoError(t, err)
+
+	if assert.Len(t, keys, 2) {
+		assert.Regexp(t, uuidPattern, keys[0])
+		assert.Regexp(t, uuidPattern, keys[1])
+		assert.NotEqual(t, keys[0], keys[1])
+	}
+}
+
+func TestIdempotencyKeys_disabledByDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("POST /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get(IdempotencyKeyHeader))
+		_, _ = w.Write([]byte(`{"data":{"identifier":"key-1"}}`))
+	})
+
+	_, _, err := client.SSHKeys.Create(ctx, &SSHKeyCreateRequest{SSHKey: SSHKey{Name: "test"}})
+
+	assert.NoError(t, err)
+}
+
+func TestIdempotencyKeys_contextKeyIsRetried(t *testing.T) {
+	setup()
+	defer teardown()
+	policy := testRetryPolicy()
+	policy.RetryWithIdempotencyKey = true
+	WithRetryPolicy(policy)(client)
+
+	var keys []string
+	mux.HandleFunc("POST /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"identifier":"key-1"}}`))
+	})
+
+	sshKey, _, err := client.SSHKeys.Create(ContextWithIdempotencyKey(ctx, "my-key"), &SSHKeyCreateRequest{SSHKey: SSHKey{Name: "test"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", sshKey.ID)
+	assert.Equal(t, []string{"my-key", "my-key", "my-key"}, keys)
+}
+
+func TestIdempotencyKeys_notRetriedByDefault(t *testing.T) {
+	setup()
+	defer teardown()
+	WithIdempotencyKeys(true)(client)
+	WithRetryPolicy(testRetryPolicy())(client)
+
+	var attempts int
+	mux.HandleFunc("POST /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		assert.NotEmpty(t, r.Header.Get(IdempotencyKeyHeader))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.SSHKeys.Create(ctx, &SSHKeyCreateRequest{SSHKey: SSHKey{Name: "test"}})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts, "a key alone must not make a POST request retryable")
+}
+
+func TestIdempotencyKeys_contextKeyIsUsedOnce(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("POST /devices", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "my-key", r.Header.Get(IdempotencyKeyHeader))
+		_, _ = w.Write([]byte(`{"data":{"identifier":"dev-1"}}`))
+	})
+	mux.HandleFunc("POST /devices/dev-1/start", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+	ctx := ContextWithIdempotencyKey(ctx, "my-key")
+
+	_, _, err := client.Devices.Create(ctx, &DeviceCreateRequest{HostName: "web-1"})
+	require.NoError(t, err)
+	_, err = client.Devices.Start(ctx, "dev-1")
+
+	assert.ErrorIs(t, err, ErrIdempotencyKeyUsed)
+	assert.Equal(t, 1, requests, "the start request must not be sent with the key of the create request")
+}
+
+func TestDevicesService_CreateOrFind_findsDeviceAfterServerError(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(testRetryPolicy())(client)
+
+	var creates int
+	mux.HandleFunc("POST /devices", func(w http.ResponseWriter, r *http.Request) {
+		creates++
+		assert.NotEmpty(t, r.Header.Get(IdempotencyKeyHeader))
+		w.WriteHeader(http.StatusGatewayTimeout)
+	})
+	mux.HandleFunc("GET /devices", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"identifier":"dev-0","hostName":"other"},{"identifier":"dev-1","hostName":"web-1"}],"meta":{"currentPage":1,"lastPage":1}}`))
+	})
+
+	device, _, err := client.Devices.CreateOrFind(ctx, &DeviceCreateRequest{HostName: "web-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "dev-1", device.ID)
+	assert.Equal(t, 1, creates, "create must not be retried before the lookup")
+}
+
+func TestDevicesService_CreateOrFind_returnsValidationError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("POST /devices", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"message":"The given data was invalid."}`))
+	})
+	mux.HandleFunc("GET /devices", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("device must not be looked up")
+	})
+
+	_, _, err := client.Devices.CreateOrFind(ctx, &DeviceCreateRequest{HostName: "web-1"})
+
+	assert.True(t, IsValidation(err))
+}
+
+func TestKubernetesService_CreateOrFind_retriesIfNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(testRetryPolicy())(client)
+
+	var keys []string
+	mux.HandleFunc("POST /kubernetes", func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"identifier":"k8s-1","name":"prod"}}`))
+	})
+	mux.HandleFunc("GET /kubernetes", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[],"meta":{"currentPage":1,"lastPage":1}}`))
+	})
+
+	cluster, _, err := client.Kubernetes.CreateOrFind(ctx, &KubernetesClusterCreateRequest{Name: "prod"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "k8s-1", cluster.ID)
+	if assert.Len(t, keys, 2) {
+		assert.Regexp(t, uuidPattern, keys[0])
+		assert.Equal(t, keys[0], keys[1], "retries must reuse the idempotency key")
+	}
+}
+
+func TestKubernetesService_CreateOrFind_withoutRetryPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	creates := 0
+	mux.HandleFunc("POST /kubernetes", func(w http.ResponseWriter, r *http.Request) {
+		creates++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	mux.HandleFunc("GET /kubernetes", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[],"meta":{"currentPage":1,"lastPage":1}}`))
+	})
+
+	_, resp, err := client.Kubernetes.CreateOrFind(ctx, &KubernetesClusterCreateRequest{Name: "prod"})
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, 1, creates, "request must not be retried without a retry policy")
+}
+
+func TestKubernetesService_CreateOrFind_findsClusterOnConflict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("POST /kubernetes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-key", r.Header.Get(IdempotencyKeyHeader))
+		w.WriteHeader(http.StatusConflict)
+	})
+	mux.HandleFunc("GET /kubernetes", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"identifier":"k8s-1","name":"prod"}],"meta":{"currentPage":1,"lastPage":1}}`))
+	})
+
+	cluster, _, err := client.Kubernetes.CreateOrFind(ContextWithIdempotencyKey(ctx, "my-key"), &KubernetesClusterCreateRequest{Name: "prod"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "k8s-1", cluster.ID)
+}
@@ -0,0 +1,199 @@
+// Package redact removes secrets like bearer tokens, passwords and secret keys
+// from URLs, HTTP headers and JSON bodies before they are logged or persisted.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Placeholder replaces every redacted value.
+const Placeholder = "REDACTED"
+
+// sensitiveKeys contains normalized names of JSON fields and query parameters
+// which hold secrets, see normalize.
+var sensitiveKeys = map[string]bool{
+	"accesstoken":          true,
+	"apikey":               true,
+	"password":             true,
+	"passwordconfirmation": true,
+	"refreshtoken":         true,
+	"secretkey":            true,
+	"token":                true,
+}
+
+// sensitiveConfigKeys contains normalized names of the YAML fields of kubeconfigs and
+// talosconfigs which hold credentials, in addition to sensitiveKeys.
+var sensitiveConfigKeys = map[string]bool{
+	"clientkeydata": true,
+	"clientsecret":  true,
+	"idtoken":       true,
+	"key":           true,
+}
+
+// sensitiveHeaders contains canonical names of HTTP headers which hold secrets.
+var sensitiveHeaders = map[string]bool{
+	"Authorization": true,
+	"Cookie":        true,
+	"Set-Cookie":    true,
+	"X-User-Id":     true,
+}
+
+// normalize lowercases key and strips separators, so "password_confirmation",
+// "passwordConfirmation" and "Password-Confirmation" are treated the same.
+func normalize(key string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+}
+
+// IsSensitiveKey reports whether key names a JSON field or query parameter holding a secret.
+func IsSensitiveKey(key string) bool {
+	return sensitiveKeys[normalize(key)]
+}
+
+// URL redacts sensitive query parameters of uri in place and returns it.
+func URL(uri *url.URL) *url.URL {
+	if uri == nil || uri.RawQuery == "" {
+		return uri
+	}
+	params := uri.Query()
+	var redacted bool
+	for key, values := range params {
+		if IsSensitiveKey(key) && len(values) > 0 && values[0] != "" {
+			params.Set(key, Placeholder)
+			redacted = true
+		}
+	}
+	if redacted {
+		uri.RawQuery = params.Encode()
+	}
+	return uri
+}
+
+// Header returns a copy of header with values of sensitive headers redacted.
+// The authentication scheme of the Authorization header is preserved.
+func Header(header http.Header) http.Header {
+	redacted := header.Clone()
+	for key, values := range redacted {
+		if !sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			continue
+		}
+		for i, value := range values {
+			if scheme, _, found := strings.Cut(value, " "); found && http.CanonicalHeaderKey(key) == "Authorization" {
+				values[i] = scheme + " " + Placeholder
+			} else {
+				values[i] = Placeholder
+			}
+		}
+	}
+	return redacted
+}
+
+// JSON returns a copy of the JSON document data with values of sensitive fields
+// redacted. Documents which cannot be parsed as JSON are replaced completely,
+// since they may contain secrets as well (e.g. a kubeconfig).
+func JSON(data []byte) []byte {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return data
+	}
+
+	var document any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return fmt.Appendf(nil, "%v (non-JSON body, %d bytes)", Placeholder, len(data))
+	}
+
+	redacted, err := json.Marshal(value(document))
+	if err != nil {
+		return fmt.Appendf(nil, "%v (non-JSON body, %d bytes)", Placeholder, len(data))
+	}
+	return redacted
+}
+
+// value redacts sensitive fields in the decoded JSON value v recursively.
+func value(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if IsSensitiveKey(key) {
+				if s, ok := nested.(string); ok && s == "" {
+					continue
+				}
+				v[key] = Placeholder
+				continue
+			}
+			v[key] = value(nested)
+		}
+	case []any:
+		for i, nested := range v {
+			v[i] = value(nested)
+		}
+	}
+	return v
+}
+
+// Body returns a copy of the request or response body data with values of sensitive
+// fields redacted. JSON documents are redacted like with JSON. YAML documents like
+// kubeconfigs and talosconfigs are redacted like with YAML. Other bodies are replaced
+// completely.
+func Body(data []byte) []byte {
+	if len(bytes.TrimSpace(data)) == 0 || json.Valid(data) {
+		return JSON(data)
+	}
+	return YAML(data)
+}
+
+// YAML returns a copy of the YAML document data with values of sensitive fields
+// redacted, including the client keys and tokens of kubeconfigs and talosconfigs.
+// Data without sensitive fields is returned unchanged. Documents which are not a
+// YAML mapping or sequence are replaced completely.
+func YAML(data []byte) []byte {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil || len(document.Content) == 0 {
+		return fmt.Appendf(nil, "%v (non-JSON body, %d bytes)", Placeholder, len(data))
+	}
+	if kind := document.Content[0].Kind; kind != yaml.MappingNode && kind != yaml.SequenceNode {
+		return fmt.Appendf(nil, "%v (non-JSON body, %d bytes)", Placeholder, len(data))
+	}
+	if !node(&document) {
+		return data
+	}
+
+	var b bytes.Buffer
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return fmt.Appendf(nil, "%v (non-JSON body, %d bytes)", Placeholder, len(data))
+	}
+	return b.Bytes()
+}
+
+// node redacts sensitive fields in the YAML node n recursively and reports whether
+// a value was redacted.
+func node(n *yaml.Node) bool {
+	var redacted bool
+	if n.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, nested := n.Content[i], n.Content[i+1]
+			if (IsSensitiveKey(key.Value) || sensitiveConfigKeys[normalize(key.Value)]) && nested.Kind == yaml.ScalarNode {
+				if nested.Value != "" {
+					nested.SetString(Placeholder)
+					redacted = true
+				}
+				continue
+			}
+			redacted = node(nested) || redacted
+		}
+		return redacted
+	}
+	for _, nested := range n.Content {
+		redacted = node(nested) || redacted
+	}
+	return redacted
+}
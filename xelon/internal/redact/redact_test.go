@@ -0,0 +1,151 @@
+package redact
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURL(t *testing.T) {
+	uri, _ := url.Parse("https://hq.xelon.ch/api/v2/devices?password=secret&search=web&token=abc")
+
+	actual := URL(uri)
+
+	assert.Equal(t, "https://hq.xelon.ch/api/v2/devices?password=REDACTED&search=web&token=REDACTED", actual.String())
+	assert.Nil(t, URL(nil))
+}
+
+func TestHeader(t *testing.T) {
+	header := http.Header{
+		"Authorization": []string{"Bearer my-secret-token"},
+		"Content-Type":  []string{"application/json"},
+		"X-User-Id":     []string{"client-1"},
+	}
+
+	actual := Header(header)
+
+	assert.Equal(t, http.Header{
+		"Authorization": []string{"Bearer REDACTED"},
+		"Content-Type":  []string{"application/json"},
+		"X-User-Id":     []string{"REDACTED"},
+	}, actual)
+	assert.Equal(t, "Bearer my-secret-token", header.Get("Authorization"))
+}
+
+func TestJSON(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected string
+	}
+	tests := map[string]testCase{
+		"device create request": {
+			input:    `{"hostName":"web-1","password":"secret","passwordConfirmation":"secret","cpu":2}`,
+			expected: `{"cpu":2,"hostName":"web-1","password":"REDACTED","passwordConfirmation":"REDACTED"}`,
+		},
+		"tenant user password update": {
+			input:    `{"password":"secret","password_confirmation":"secret"}`,
+			expected: `{"password":"REDACTED","password_confirmation":"REDACTED"}`,
+		},
+		"nested object storage token": {
+			input:    `{"data":{"tokens":[{"accessKey":"ak","secretKey":"sk"}]}}`,
+			expected: `{"data":{"tokens":[{"accessKey":"ak","secretKey":"REDACTED"}]}}`,
+		},
+		"empty secret is kept": {
+			input:    `{"secretKey":""}`,
+			expected: `{"secretKey":""}`,
+		},
+		"large numbers are preserved": {
+			input:    `{"id":12345678901234567890}`,
+			expected: `{"id":12345678901234567890}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.JSONEq(t, test.expected, string(JSON([]byte(test.input))))
+		})
+	}
+}
+
+func TestJSON_nonJSONBody(t *testing.T) {
+	assert.Equal(t, "REDACTED (non-JSON body, 17 bytes)", string(JSON([]byte("apiVersion: v1\nx:"))))
+	assert.Empty(t, JSON(nil))
+}
+
+func TestYAML(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected string
+	}
+	tests := map[string]testCase{
+		"kubeconfig": {
+			input: `apiVersion: v1
+clusters:
+  - cluster:
+      certificate-authority-data: Y2E=
+      server: https://k8s.example.com:6443
+    name: production
+users:
+  - name: admin
+    user:
+      client-certificate-data: Y3J0
+      client-key-data: a2V5
+`,
+			expected: `apiVersion: v1
+clusters:
+  - cluster:
+      certificate-authority-data: Y2E=
+      server: https://k8s.example.com:6443
+    name: production
+users:
+  - name: admin
+    user:
+      client-certificate-data: Y3J0
+      client-key-data: REDACTED
+`,
+		},
+		"talosconfig": {
+			input: `context: production
+contexts:
+  production:
+    endpoints:
+      - 10.0.0.1
+    ca: Y2E=
+    crt: Y3J0
+    key: a2V5
+`,
+			expected: `context: production
+contexts:
+  production:
+    endpoints:
+      - 10.0.0.1
+    ca: Y2E=
+    crt: Y3J0
+    key: REDACTED
+`,
+		},
+		"without secrets is unchanged": {
+			input:    "apiVersion:   v1\nkind: Config\n",
+			expected: "apiVersion:   v1\nkind: Config\n",
+		},
+		"plain text": {
+			input:    "secret-token",
+			expected: "REDACTED (non-JSON body, 12 bytes)",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, string(YAML([]byte(test.input))))
+		})
+	}
+}
+
+func TestBody(t *testing.T) {
+	assert.JSONEq(t, `{"password":"REDACTED"}`, string(Body([]byte(`{"password":"secret"}`))))
+	assert.Equal(t, "token: REDACTED\n", string(Body([]byte("token: secret\n"))))
+	assert.Equal(t, "REDACTED (non-JSON body, 9 bytes)", string(Body([]byte("Not Found"))))
+	assert.Empty(t, Body(nil))
+}
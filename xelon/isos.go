@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -12,6 +13,18 @@ const isoBasePath = "isos"
 // ISOsService handles communication with the ISO related methods of the Xelon REST API.
 type ISOsService service
 
+// ISOsAPI is the interface implemented by ISOsService.
+type ISOsAPI interface {
+	List(ctx context.Context, opts *ISOListOptions) ([]ISO, *Response, error)
+	All(ctx context.Context, opts *ISOListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[ISO, *Response], func() error)
+	Get(ctx context.Context, isoID string) (*ISO, *Response, error)
+	Create(ctx context.Context, createRequest *ISOCreateRequest) (*ISO, *Response, error)
+	Update(ctx context.Context, isoID string, updateRequest *ISOUpdateRequest) (*ISO, *Response, error)
+	Delete(ctx context.Context, isoID string) (*Response, error)
+}
+
+var _ ISOsAPI = (*ISOsService)(nil)
+
 // ISO represents a Xelon custom ISO.
 type ISO struct {
 	Active      bool   `json:"active,omitempty"`
@@ -39,10 +52,30 @@ type ISOUpdateRequest struct {
 	Name        string `json:"name"`
 }
 
+// ISOSort is a field ISOs can be sorted by, use SortDesc for descending order.
+type ISOSort string
+
+const (
+	ISOSortName     ISOSort = "name"
+	ISOSortCategory ISOSort = "category"
+	ISOSortStatus   ISOSort = "status"
+)
+
+func (ISOSort) sortFields() []string {
+	return sortFieldNames(
+		ISOSortName,
+		ISOSortCategory,
+		ISOSortStatus,
+	)
+}
+
 // ISOListOptions specifies the optional parameters to the ISOsService.List.
 type ISOListOptions struct {
-	Sort   string `url:"sort,omitempty"`
-	Search string `url:"search,omitempty"`
+	Sort   ISOSort `url:"sort,omitempty"`
+	Search string  `url:"search,omitempty"`
+
+	// CloudID filters the list by cloud.
+	CloudID string `url:"cloudIdentifier,omitempty"`
 
 	ListOptions
 }
@@ -61,7 +94,7 @@ func (v ISO) String() string { return Stringify(v) }
 
 // List provides a list of all custom ISOs.
 func (s *ISOsService) List(ctx context.Context, opts *ISOListOptions) ([]ISO, *Response, error) {
-	path, err := addOptions(isoBasePath, opts)
+	path, err := s.client.addListOptions(isoBasePath, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -72,7 +105,7 @@ func (s *ISOsService) List(ctx context.Context, opts *ISOListOptions) ([]ISO, *R
 	}
 
 	root := new(isosRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "ISOsService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -83,6 +116,13 @@ func (s *ISOsService) List(ctx context.Context, opts *ISOListOptions) ([]ISO, *R
 	return root.ISOs, resp, nil
 }
 
+// All returns an iterator to paginate over all ISOs.
+//
+// The return iterator can be used in a for...range loop to easily process all ISOs.
+func (s *ISOsService) All(ctx context.Context, opts *ISOListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[ISO, *Response], func() error) {
+	return newPaginator[ISO](withOperation(ctx, "ISOsService.All"), s.client, isoBasePath, opts, paginatorOpts...)
+}
+
 // Get provides detailed information for custom ISO identified by id.
 func (s *ISOsService) Get(ctx context.Context, isoID string) (*ISO, *Response, error) {
 	if isoID == "" {
@@ -96,7 +136,7 @@ func (s *ISOsService) Get(ctx context.Context, isoID string) (*ISO, *Response, e
 	}
 
 	iso := new(ISO)
-	resp, err := s.client.Do(ctx, req, iso)
+	resp, err := s.client.Do(withOperation(ctx, "ISOsService.Get"), req, iso)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -116,7 +156,7 @@ func (s *ISOsService) Create(ctx context.Context, createRequest *ISOCreateReques
 	}
 
 	isoRoot := new(isoRoot)
-	resp, err := s.client.Do(ctx, req, isoRoot)
+	resp, err := s.client.Do(withOperation(ctx, "ISOsService.Create"), req, isoRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -140,7 +180,7 @@ func (s *ISOsService) Update(ctx context.Context, isoID string, updateRequest *I
 	}
 
 	isoRoot := new(isoRoot)
-	resp, err := s.client.Do(ctx, req, isoRoot)
+	resp, err := s.client.Do(withOperation(ctx, "ISOsService.Update"), req, isoRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -160,5 +200,5 @@ func (s *ISOsService) Delete(ctx context.Context, isoID string) (*Response, erro
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "ISOsService.Delete"), req, nil)
 }
@@ -9,6 +9,7 @@ import (
 	"iter"
 	"net/http"
 	"net/netip"
+	"strings"
 	"time"
 )
 
@@ -17,6 +18,35 @@ const kubernetesBasePath = "kubernetes"
 // KubernetesService handles communication with the Kubernetes related methods of the Xelon API.
 type KubernetesService service
 
+// KubernetesAPI is the interface implemented by KubernetesService.
+type KubernetesAPI interface {
+	List(ctx context.Context, opts *ListOptions) ([]KubernetesCluster, *Response, error)
+	All(ctx context.Context, opts *ListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[KubernetesCluster, *Response], func() error)
+	Get(ctx context.Context, kubernetesClusterID string) (*KubernetesCluster, *Response, error)
+	Create(ctx context.Context, createRequest *KubernetesClusterCreateRequest) (*KubernetesCluster, *Response, error)
+	CreateOrFind(ctx context.Context, createRequest *KubernetesClusterCreateRequest) (*KubernetesCluster, *Response, error)
+	Delete(ctx context.Context, kubernetesClusterID string) (*Response, error)
+	UpgradeHighAvailability(ctx context.Context, kubernetesClusterID string) (*Response, error)
+	GetKubeconfig(ctx context.Context, kubernetesClusterID string) ([]byte, *Response, error)
+	GetTalosconfig(ctx context.Context, kubernetesClusterID string) ([]byte, *Response, error)
+	ListControlPlane(ctx context.Context, kubernetesClusterID string) (*KubernetesClusterControlPlane, *Response, error)
+	UpdateControlPlane(ctx context.Context, kubernetesClusterID string, updateRequest *KubernetesClusterControlPlaneUpdateRequest) (*Response, error)
+	ListNodePools(ctx context.Context, kubernetesClusterID string) ([]KubernetesClusterNodePool, *Response, error)
+	GetNodePool(ctx context.Context, kubernetesClusterID, nodePoolID string) (*KubernetesClusterNodePool, *Response, error)
+	CreateNodePool(ctx context.Context, kubernetesClusterID string, createRequest *KubernetesClusterNodePoolCreateRequest) (*KubernetesClusterNodePool, *Response, error)
+	UpdateNodePool(ctx context.Context, kubernetesClusterID, nodePoolID string, updateRequest *KubernetesClusterNodePoolUpdateRequest) (*Response, error)
+	DeleteNodePool(ctx context.Context, kubernetesClusterID, nodePoolID string) (*Response, error)
+	CreateNode(ctx context.Context, kubernetesClusterID, nodePoolID string) (*Response, error)
+	DeleteNode(ctx context.Context, kubernetesClusterID, nodeID string) (*Response, error)
+	ListLoadBalancer(ctx context.Context, kubernetesClusterID string) (*KubernetesClusterLoadBalancer, *Response, error)
+	ListVersionMapping(ctx context.Context, cloudID string) (KubernetesClusterVersionMapping, *Response, error)
+	UpgradeKubernetesVersion(ctx context.Context, kubernetesClusterID string, upgradeRequest *KubernetesClusterVersionUpgradeRequest) (*Response, error)
+	UpgradeTalosVersion(ctx context.Context, kubernetesClusterID string, upgradeRequest *KubernetesClusterVersionUpgradeRequest) (*Response, error)
+	WaitForStatus(ctx context.Context, kubernetesClusterID string, status KubernetesClusterStatus, opts *WaitOptions) (*KubernetesCluster, error)
+}
+
+var _ KubernetesAPI = (*KubernetesService)(nil)
+
 // KubernetesCluster represents a Xelon Kubernetes cluster.
 type KubernetesCluster struct {
 	Cloud     *Cloud                   `json:"cloud,omitempty"`
@@ -24,9 +54,20 @@ type KubernetesCluster struct {
 	Health    *KubernetesClusterHealth `json:"health,omitempty"`
 	ID        string                   `json:"identifier,omitempty"`
 	Name      string                   `json:"name,omitempty"`
-	Status    string                   `json:"status,omitempty"`
+	Status    KubernetesClusterStatus  `json:"status,omitempty"`
 }
 
+// KubernetesClusterStatus is the status of a Kubernetes cluster.
+type KubernetesClusterStatus string
+
+const (
+	// KubernetesClusterStatusProvisioning is the status of a Kubernetes cluster which is being created.
+	KubernetesClusterStatusProvisioning KubernetesClusterStatus = "provisioning"
+
+	// KubernetesClusterStatusReady is the status of a Kubernetes cluster which is ready to use.
+	KubernetesClusterStatusReady KubernetesClusterStatus = "ready"
+)
+
 type KubernetesClusterHealth struct {
 	Status           string `json:"health,omitempty"`
 	LastCheckingData string `json:"lastCheckingData,omitempty"`
@@ -110,7 +151,7 @@ func (s *KubernetesService) List(ctx context.Context, opts *ListOptions) ([]Kube
 	}
 
 	root := new(kubernetesClustersRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -124,8 +165,8 @@ func (s *KubernetesService) List(ctx context.Context, opts *ListOptions) ([]Kube
 // All returns an iterator to paginate over all available Kubernetes clusters.
 //
 // The return iterator can be used in a for...range loop to easily process all Kubernetes clusters.
-func (s *KubernetesService) All(ctx context.Context, opts *ListOptions) (iter.Seq2[KubernetesCluster, *Response], func() error) {
-	return newPaginator[KubernetesCluster](ctx, s.client, kubernetesBasePath, opts)
+func (s *KubernetesService) All(ctx context.Context, opts *ListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[KubernetesCluster, *Response], func() error) {
+	return newPaginator[KubernetesCluster](withOperation(ctx, "KubernetesService.All"), s.client, kubernetesBasePath, opts, paginatorOpts...)
 }
 
 // Get provides detailed information for Kubernetes cluster identified by id.
@@ -141,7 +182,7 @@ func (s *KubernetesService) Get(ctx context.Context, kubernetesClusterID string)
 	}
 
 	root := new(kubernetesClusterRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.Get"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -161,7 +202,7 @@ func (s *KubernetesService) Create(ctx context.Context, createRequest *Kubernete
 	}
 
 	root := new(kubernetesClusterRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.Create"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -169,6 +210,34 @@ func (s *KubernetesService) Create(ctx context.Context, createRequest *Kubernete
 	return root.KubernetesCluster, resp, nil
 }
 
+// CreateOrFind makes a new Kubernetes cluster like Create, but can be retried safely.
+// If the outcome of the request is unknown, e.g. because of a client-side timeout, the
+// cluster is looked up by its name before the request is retried. An existing cluster
+// with the same name is returned instead of creating a duplicate.
+func (s *KubernetesService) CreateOrFind(ctx context.Context, createRequest *KubernetesClusterCreateRequest) (*KubernetesCluster, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create kubernetes cluster: payload must be supplied")
+	}
+	if createRequest.Name == "" {
+		return nil, nil, errors.New("failed to create kubernetes cluster: name must be supplied")
+	}
+
+	return createOrFind(ctx, s.client,
+		func(ctx context.Context) (*KubernetesCluster, *Response, error) {
+			return s.Create(ctx, createRequest)
+		},
+		func(ctx context.Context) (*KubernetesCluster, *Response, error) {
+			clusters, errFunc := s.All(ctx, nil)
+			for cluster, resp := range clusters {
+				if cluster.Name == createRequest.Name {
+					return &cluster, resp, nil
+				}
+			}
+			return nil, nil, errFunc()
+		},
+	)
+}
+
 // Delete removes Kubernetes cluster identified by id.
 func (s *KubernetesService) Delete(ctx context.Context, kubernetesClusterID string) (*Response, error) {
 	if kubernetesClusterID == "" {
@@ -181,7 +250,7 @@ func (s *KubernetesService) Delete(ctx context.Context, kubernetesClusterID stri
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "KubernetesService.Delete"), req, nil)
 }
 
 // UpgradeHighAvailability enables high availability for the Kubernetes cluster.
@@ -196,7 +265,7 @@ func (s *KubernetesService) UpgradeHighAvailability(ctx context.Context, kuberne
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "KubernetesService.UpgradeHighAvailability"), req, nil)
 }
 
 // GetKubeconfig returns the raw Kubernetes config in YAML format.
@@ -212,7 +281,7 @@ func (s *KubernetesService) GetKubeconfig(ctx context.Context, kubernetesCluster
 	req.Header.Set("Accept", "application/yaml")
 
 	var buf bytes.Buffer
-	resp, err := s.client.Do(ctx, req, &buf)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.GetKubeconfig"), req, &buf)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -233,7 +302,7 @@ func (s *KubernetesService) GetTalosconfig(ctx context.Context, kubernetesCluste
 	req.Header.Set("Accept", "application/yaml")
 
 	var buf bytes.Buffer
-	resp, err := s.client.Do(ctx, req, &buf)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.GetTalosconfig"), req, &buf)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -312,7 +381,7 @@ func (s *KubernetesService) ListControlPlane(ctx context.Context, kubernetesClus
 	}
 
 	controlPlane := new(KubernetesClusterControlPlane)
-	resp, err := s.client.Do(ctx, req, controlPlane)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.ListControlPlane"), req, controlPlane)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -335,7 +404,7 @@ func (s *KubernetesService) UpdateControlPlane(ctx context.Context, kubernetesCl
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "KubernetesService.UpdateControlPlane"), req, nil)
 }
 
 // ListNodePools provides information about nodes pools on Kubernetes cluster.
@@ -351,7 +420,7 @@ func (s *KubernetesService) ListNodePools(ctx context.Context, kubernetesCluster
 	}
 
 	var nodePools []KubernetesClusterNodePool
-	resp, err := s.client.Do(ctx, req, &nodePools)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.ListNodePools"), req, &nodePools)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -375,7 +444,7 @@ func (s *KubernetesService) GetNodePool(ctx context.Context, kubernetesClusterID
 	}
 
 	nodePool := new(KubernetesClusterNodePool)
-	resp, err := s.client.Do(ctx, req, nodePool)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.GetNodePool"), req, nodePool)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -399,7 +468,7 @@ func (s *KubernetesService) CreateNodePool(ctx context.Context, kubernetesCluste
 	}
 
 	root := new(kubernetesClusterNodePoolRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.CreateNodePool"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -425,7 +494,7 @@ func (s *KubernetesService) UpdateNodePool(ctx context.Context, kubernetesCluste
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "KubernetesService.UpdateNodePool"), req, nil)
 }
 
 // DeleteNodePool removes the node pool.
@@ -443,7 +512,7 @@ func (s *KubernetesService) DeleteNodePool(ctx context.Context, kubernetesCluste
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "KubernetesService.DeleteNodePool"), req, nil)
 }
 
 // CreateNode makes a new node in Kubernetes cluster.
@@ -461,7 +530,7 @@ func (s *KubernetesService) CreateNode(ctx context.Context, kubernetesClusterID,
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "KubernetesService.CreateNode"), req, nil)
 }
 
 // DeleteNode removes node in Kubernetes cluster.
@@ -479,7 +548,7 @@ func (s *KubernetesService) DeleteNode(ctx context.Context, kubernetesClusterID,
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "KubernetesService.DeleteNode"), req, nil)
 }
 
 type KubernetesClusterLoadBalancer struct {
@@ -511,7 +580,7 @@ func (s *KubernetesService) ListLoadBalancer(ctx context.Context, kubernetesClus
 	}
 
 	var loadBalancer []KubernetesClusterLoadBalancer
-	resp, err := s.client.Do(ctx, req, &loadBalancer)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.ListLoadBalancer"), req, &loadBalancer)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -552,7 +621,7 @@ func (s *KubernetesService) ListVersionMapping(ctx context.Context, cloudID stri
 	}
 
 	var mapping KubernetesClusterVersionMapping
-	resp, err := s.client.Do(ctx, req, &mapping)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesService.ListVersionMapping"), req, &mapping)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -575,7 +644,7 @@ func (s *KubernetesService) UpgradeKubernetesVersion(ctx context.Context, kubern
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "KubernetesService.UpgradeKubernetesVersion"), req, nil)
 }
 
 // UpgradeTalosVersion upgrades the cluster's Talos version.
@@ -593,5 +662,24 @@ func (s *KubernetesService) UpgradeTalosVersion(ctx context.Context, kubernetesC
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "KubernetesService.UpgradeTalosVersion"), req, nil)
+}
+
+// WaitForStatus waits until the Kubernetes cluster identified by id reaches status
+// (compared case-insensitively), e.g. after calling Create. It returns the last fetched cluster.
+func (s *KubernetesService) WaitForStatus(ctx context.Context, kubernetesClusterID string, status KubernetesClusterStatus, opts *WaitOptions) (*KubernetesCluster, error) {
+	if kubernetesClusterID == "" {
+		return nil, errors.New("failed to wait for kubernetes cluster status: id must be supplied")
+	}
+
+	return Wait(ctx,
+		func(ctx context.Context) (*KubernetesCluster, error) {
+			kubernetesCluster, _, err := s.Get(ctx, kubernetesClusterID)
+			return kubernetesCluster, err
+		},
+		func(kubernetesCluster *KubernetesCluster) (bool, error) {
+			return kubernetesCluster != nil && strings.EqualFold(string(kubernetesCluster.Status), string(status)), nil
+		},
+		opts,
+	)
 }
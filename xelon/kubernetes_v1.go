@@ -12,6 +12,17 @@ const kubernetesTalosBasePath = "kubernetes-talos"
 // related methods of the Xelon API.
 type KubernetesTalosService service
 
+// KubernetesTalosAPI is the interface implemented by KubernetesTalosService.
+type KubernetesTalosAPI interface {
+	List(ctx context.Context) ([]KubernetesTalosCluster, *Response, error)
+	ListControlPlanes(ctx context.Context, kubernetesClusterID string) (*ClusterControlPlane, *Response, error)
+	ListClusterPools(ctx context.Context, kubernetesClusterID string) ([]ClusterPool, *Response, error)
+	AddClusterNode(ctx context.Context, kubernetesClusterID, clusterPoolID string) (*SuccessResponse, *Response, error)
+	DeleteClusterNode(ctx context.Context, kubernetesClusterID, clusterNodeID string) (*SuccessResponse, *Response, error)
+}
+
+var _ KubernetesTalosAPI = (*KubernetesTalosService)(nil)
+
 // KubernetesTalosCluster represents a Xelon Kubernetes cluster.
 type KubernetesTalosCluster struct {
 	Cloud     *Cloud                        `json:"hv_system,omitempty"`
@@ -77,7 +88,7 @@ func (s *KubernetesTalosService) List(ctx context.Context) ([]KubernetesTalosClu
 	}
 
 	var kubernetesClusters []KubernetesTalosCluster
-	resp, err := s.client.Do(ctx, req, &kubernetesClusters)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesTalosService.List"), req, &kubernetesClusters)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -98,7 +109,7 @@ func (s *KubernetesTalosService) ListControlPlanes(ctx context.Context, kubernet
 	}
 
 	clusterControlPlane := new(ClusterControlPlane)
-	resp, err := s.client.Do(ctx, req, clusterControlPlane)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesTalosService.ListControlPlanes"), req, clusterControlPlane)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -119,7 +130,7 @@ func (s *KubernetesTalosService) ListClusterPools(ctx context.Context, kubernete
 	}
 
 	var clusterPools []ClusterPool
-	resp, err := s.client.Do(ctx, req, &clusterPools)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesTalosService.ListClusterPools"), req, &clusterPools)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -140,7 +151,7 @@ func (s *KubernetesTalosService) AddClusterNode(ctx context.Context, kubernetesC
 	}
 
 	successResponse := new(SuccessResponse)
-	resp, err := s.client.Do(ctx, req, successResponse)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesTalosService.AddClusterNode"), req, successResponse)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -161,7 +172,7 @@ func (s *KubernetesTalosService) DeleteClusterNode(ctx context.Context, kubernet
 	}
 
 	successResponse := new(SuccessResponse)
-	resp, err := s.client.Do(ctx, req, successResponse)
+	resp, err := s.client.Do(withOperation(ctx, "KubernetesTalosService.DeleteClusterNode"), req, successResponse)
 	if err != nil {
 		return nil, resp, err
 	}
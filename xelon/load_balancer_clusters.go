@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 const loadBalancerClusterBasePath = "load-balancer-clusters"
@@ -12,17 +13,42 @@ const loadBalancerClusterBasePath = "load-balancer-clusters"
 // related methods of the Xelon API.
 type LoadBalancerClustersService service
 
+// LoadBalancerClustersAPI is the interface implemented by LoadBalancerClustersService.
+type LoadBalancerClustersAPI interface {
+	List(ctx context.Context) ([]LoadBalancerCluster, *Response, error)
+	Get(ctx context.Context, loadBalancerClusterID string) (*LoadBalancerCluster, *Response, error)
+	Create(ctx context.Context, createRequest *LoadBalancerClusterCreateRequest) (*LoadBalancerClusterCreateResponse, *Response, error)
+	Delete(ctx context.Context, loadBalancerClusterID string) (*Response, error)
+	ListVirtualIPs(ctx context.Context, loadBalancerClusterID string) ([]LoadBalancerClusterVirtualIP, *Response, error)
+	GetVirtualIP(ctx context.Context, loadBalancerClusterID, virtualIPID string) (*LoadBalancerClusterVirtualIP, *Response, error)
+	ListForwardingRules(ctx context.Context, loadBalancerClusterID, virtualIPID string) ([]LoadBalancerClusterForwardingRule, *Response, error)
+	CreateForwardingRules(ctx context.Context, loadBalancerClusterID, virtualIPID string, createRequest []LoadBalancerClusterForwardingRule) ([]LoadBalancerClusterForwardingRule, *Response, error)
+	UpdateForwardingRule(ctx context.Context, loadBalancerClusterID, virtualIPID, forwardingRuleID string, updateRequest *LoadBalancerClusterForwardingRuleUpdateResponse) (*APIResponse, *Response, error)
+	DeleteForwardingRule(ctx context.Context, loadBalancerClusterID, virtualIPID, forwardingRuleID string) (*Response, error)
+	WaitForStatus(ctx context.Context, loadBalancerClusterID string, status LoadBalancerClusterStatus, opts *WaitOptions) (*LoadBalancerCluster, error)
+}
+
+var _ LoadBalancerClustersAPI = (*LoadBalancerClustersService)(nil)
+
 // LoadBalancerCluster represents a Xelon load balancer cluster.
 type LoadBalancerCluster struct {
-	Cloud               *Cloud   `json:"hv_system,omitempty"`
-	ID                  string   `json:"identifier,omitempty"`
-	KubernetesClusterID string   `json:"kubernetesClusterIdentifier,omitempty"`
-	Name                string   `json:"name,omitempty"`
-	Nodes               []string `json:"nodes,omitempty"`
-	Status              string   `json:"status,omitempty"`
-	TenantID            string   `json:"tenantIdentifier,omitempty"`
+	Cloud               *Cloud                    `json:"hv_system,omitempty"`
+	ID                  string                    `json:"identifier,omitempty"`
+	KubernetesClusterID string                    `json:"kubernetesClusterIdentifier,omitempty"`
+	Name                string                    `json:"name,omitempty"`
+	Nodes               []string                  `json:"nodes,omitempty"`
+	Status              LoadBalancerClusterStatus `json:"status,omitempty"`
+	TenantID            string                    `json:"tenantIdentifier,omitempty"`
 }
 
+// LoadBalancerClusterStatus is the status of a load balancer cluster.
+type LoadBalancerClusterStatus string
+
+const (
+	// LoadBalancerClusterStatusActive is the status of a load balancer cluster which is ready to use.
+	LoadBalancerClusterStatusActive LoadBalancerClusterStatus = "active"
+)
+
 type LoadBalancerClusterCreateRequest struct {
 	CloudID                     int                           `json:"cloudId"`
 	KubernetesClusterIdentifier string                        `json:"kubernetesClusterIdentifier"`
@@ -31,8 +57,8 @@ type LoadBalancerClusterCreateRequest struct {
 }
 
 type LoadBalancerClusterCreateResponse struct {
-	LoadBalancerClusterID string `json:"identifier"`
-	Status                string `json:"status"`
+	LoadBalancerClusterID string                    `json:"identifier"`
+	Status                LoadBalancerClusterStatus `json:"status"`
 }
 
 type LoadBalancerClusterNodesSpec struct {
@@ -78,7 +104,7 @@ func (s *LoadBalancerClustersService) List(ctx context.Context) ([]LoadBalancerC
 	}
 
 	var loadBalancerClusters []LoadBalancerCluster
-	resp, err := s.client.Do(ctx, req, &loadBalancerClusters)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancerClustersService.List"), req, &loadBalancerClusters)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -99,7 +125,7 @@ func (s *LoadBalancerClustersService) Get(ctx context.Context, loadBalancerClust
 	}
 
 	loadBalancerCluster := new(LoadBalancerCluster)
-	resp, err := s.client.Do(ctx, req, loadBalancerCluster)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancerClustersService.Get"), req, loadBalancerCluster)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -120,7 +146,7 @@ func (s *LoadBalancerClustersService) Create(ctx context.Context, createRequest
 	}
 
 	apiResponse := new(LoadBalancerClusterCreateResponse)
-	resp, err := s.client.Do(ctx, req, apiResponse)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancerClustersService.Create"), req, apiResponse)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -140,7 +166,7 @@ func (s *LoadBalancerClustersService) Delete(ctx context.Context, loadBalancerCl
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "LoadBalancerClustersService.Delete"), req, nil)
 }
 
 // ListVirtualIPs provides information about virtual IP addresses.
@@ -156,7 +182,7 @@ func (s *LoadBalancerClustersService) ListVirtualIPs(ctx context.Context, loadBa
 	}
 
 	var virtualIPs []LoadBalancerClusterVirtualIP
-	resp, err := s.client.Do(ctx, req, &virtualIPs)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancerClustersService.ListVirtualIPs"), req, &virtualIPs)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -177,7 +203,7 @@ func (s *LoadBalancerClustersService) GetVirtualIP(ctx context.Context, loadBala
 	}
 
 	virtualIP := new(LoadBalancerClusterVirtualIP)
-	resp, err := s.client.Do(ctx, req, virtualIP)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancerClustersService.GetVirtualIP"), req, virtualIP)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -198,7 +224,7 @@ func (s *LoadBalancerClustersService) ListForwardingRules(ctx context.Context, l
 	}
 
 	var forwardingRules []LoadBalancerClusterForwardingRule
-	resp, err := s.client.Do(ctx, req, &forwardingRules)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancerClustersService.ListForwardingRules"), req, &forwardingRules)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -222,7 +248,7 @@ func (s *LoadBalancerClustersService) CreateForwardingRules(ctx context.Context,
 	}
 
 	var forwardingRules []LoadBalancerClusterForwardingRule
-	resp, err := s.client.Do(ctx, req, &forwardingRules)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancerClustersService.CreateForwardingRules"), req, &forwardingRules)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -246,7 +272,7 @@ func (s *LoadBalancerClustersService) UpdateForwardingRule(ctx context.Context,
 	}
 
 	apiResponse := new(APIResponse)
-	resp, err := s.client.Do(ctx, req, apiResponse)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancerClustersService.UpdateForwardingRule"), req, apiResponse)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -266,5 +292,24 @@ func (s *LoadBalancerClustersService) DeleteForwardingRule(ctx context.Context,
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "LoadBalancerClustersService.DeleteForwardingRule"), req, nil)
+}
+
+// WaitForStatus waits until the load balancer cluster identified by id reaches status
+// (compared case-insensitively), e.g. after calling Create. It returns the last fetched cluster.
+func (s *LoadBalancerClustersService) WaitForStatus(ctx context.Context, loadBalancerClusterID string, status LoadBalancerClusterStatus, opts *WaitOptions) (*LoadBalancerCluster, error) {
+	if loadBalancerClusterID == "" {
+		return nil, ErrEmptyArgument
+	}
+
+	return Wait(ctx,
+		func(ctx context.Context) (*LoadBalancerCluster, error) {
+			loadBalancerCluster, _, err := s.Get(ctx, loadBalancerClusterID)
+			return loadBalancerCluster, err
+		},
+		func(loadBalancerCluster *LoadBalancerCluster) (bool, error) {
+			return strings.EqualFold(string(loadBalancerCluster.Status), string(status)), nil
+		},
+		opts,
+	)
 }
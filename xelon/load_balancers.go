@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -13,6 +14,23 @@ const loadBalancerBasePath = "load-balancers"
 // LoadBalancersService handles communication with the load balancers related methods of the Xelon REST API.
 type LoadBalancersService service
 
+// LoadBalancersAPI is the interface implemented by LoadBalancersService.
+type LoadBalancersAPI interface {
+	List(ctx context.Context, opts *LoadBalancerListOptions) ([]LoadBalancer, *Response, error)
+	All(ctx context.Context, opts *LoadBalancerListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[LoadBalancer, *Response], func() error)
+	Get(ctx context.Context, loadBalancerID string) (*LoadBalancer, *Response, error)
+	Create(ctx context.Context, createRequest *LoadBalancerCreateRequest) (*LoadBalancer, *Response, error)
+	Update(ctx context.Context, loadBalancerID string, updateRequest *LoadBalancerUpdateRequest) (*LoadBalancer, *Response, error)
+	Delete(ctx context.Context, loadBalancerID string) (*Response, error)
+	ListAssignedDevices(ctx context.Context, loadBalancerID, networkID string) ([]LoadBalancerAssignedDevice, *Response, error)
+	UpdateAssignedDevices(ctx context.Context, loadBalancerID string, updateRequest *LoadBalancerUpdateAssignedDevicesRequest) (*Response, error)
+	CreateForwardingRule(ctx context.Context, loadBalancerID string, createRequest *LoadBalancerCreateForwardingRuleRequest) (*LoadBalancerForwardingRule, *Response, error)
+	UpdateForwardingRule(ctx context.Context, loadBalancerID, forwardingRuleID string, updateRequest *LoadBalancerUpdateForwardingRuleRequest) (*LoadBalancerForwardingRule, *Response, error)
+	DeleteForwardingRule(ctx context.Context, loadBalancerID string, forwardingRuleID string) (*Response, error)
+}
+
+var _ LoadBalancersAPI = (*LoadBalancersService)(nil)
+
 // LoadBalancer represents a Xelon load balancer.
 type LoadBalancer struct {
 	AssignedDevices   []LoadBalancerAssignedDevice `json:"assignedDevices,omitempty"`
@@ -70,10 +88,34 @@ type LoadBalancerUpdateForwardingRuleRequest struct {
 	LoadBalancerForwardingRule
 }
 
+// LoadBalancerSort is a field load balancers can be sorted by, use SortDesc for descending order.
+type LoadBalancerSort string
+
+const (
+	LoadBalancerSortName      LoadBalancerSort = "name"
+	LoadBalancerSortType      LoadBalancerSort = "type"
+	LoadBalancerSortState     LoadBalancerSort = "state"
+	LoadBalancerSortCreatedAt LoadBalancerSort = "createdAt"
+)
+
+func (LoadBalancerSort) sortFields() []string {
+	return sortFieldNames(
+		LoadBalancerSortName,
+		LoadBalancerSortType,
+		LoadBalancerSortState,
+		LoadBalancerSortCreatedAt,
+	)
+}
+
 // LoadBalancerListOptions specifies the optional parameters to the LoadBalancersService.List.
 type LoadBalancerListOptions struct {
-	Sort   string `url:"sort,omitempty"`
-	Search string `url:"search,omitempty"`
+	Sort   LoadBalancerSort `url:"sort,omitempty"`
+	Search string           `url:"search,omitempty"`
+
+	// CloudID, TenantID and State filter the list by cloud, tenant and state.
+	CloudID  string `url:"cloudIdentifier,omitempty"`
+	TenantID string `url:"tenantIdentifier,omitempty"`
+	State    *int   `url:"state,omitempty"`
 
 	ListOptions
 }
@@ -101,7 +143,7 @@ func (v LoadBalancer) String() string { return Stringify(v) }
 
 // List provides a list of all load balancers.
 func (s *LoadBalancersService) List(ctx context.Context, opts *LoadBalancerListOptions) ([]LoadBalancer, *Response, error) {
-	path, err := addOptions(loadBalancerBasePath, opts)
+	path, err := s.client.addListOptions(loadBalancerBasePath, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -112,7 +154,7 @@ func (s *LoadBalancersService) List(ctx context.Context, opts *LoadBalancerListO
 	}
 
 	root := new(loadBalancersRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -123,6 +165,13 @@ func (s *LoadBalancersService) List(ctx context.Context, opts *LoadBalancerListO
 	return root.LoadBalancers, resp, nil
 }
 
+// All returns an iterator to paginate over all load balancers.
+//
+// The return iterator can be used in a for...range loop to easily process all load balancers.
+func (s *LoadBalancersService) All(ctx context.Context, opts *LoadBalancerListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[LoadBalancer, *Response], func() error) {
+	return newPaginator[LoadBalancer](withOperation(ctx, "LoadBalancersService.All"), s.client, loadBalancerBasePath, opts, paginatorOpts...)
+}
+
 // Get provides detailed information for load balancer identified by id.
 func (s *LoadBalancersService) Get(ctx context.Context, loadBalancerID string) (*LoadBalancer, *Response, error) {
 	if loadBalancerID == "" {
@@ -136,7 +185,7 @@ func (s *LoadBalancersService) Get(ctx context.Context, loadBalancerID string) (
 	}
 
 	loadBalancer := new(LoadBalancer)
-	resp, err := s.client.Do(ctx, req, loadBalancer)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersService.Get"), req, loadBalancer)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -156,7 +205,7 @@ func (s *LoadBalancersService) Create(ctx context.Context, createRequest *LoadBa
 	}
 
 	loadBalancerRoot := new(loadBalancerRoot)
-	resp, err := s.client.Do(ctx, req, loadBalancerRoot)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersService.Create"), req, loadBalancerRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -180,7 +229,7 @@ func (s *LoadBalancersService) Update(ctx context.Context, loadBalancerID string
 	}
 
 	loadBalancerRoot := new(loadBalancerRoot)
-	resp, err := s.client.Do(ctx, req, loadBalancerRoot)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersService.Update"), req, loadBalancerRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -200,7 +249,7 @@ func (s *LoadBalancersService) Delete(ctx context.Context, loadBalancerID string
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "LoadBalancersService.Delete"), req, nil)
 }
 
 // ListAssignedDevices provides information about assigned device.
@@ -219,7 +268,7 @@ func (s *LoadBalancersService) ListAssignedDevices(ctx context.Context, loadBala
 	}
 
 	root := new(loadBalancerAssignedDevicesRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersService.ListAssignedDevices"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -243,7 +292,7 @@ func (s *LoadBalancersService) UpdateAssignedDevices(ctx context.Context, loadBa
 	}
 
 	root := new(loadBalancerRoot)
-	return s.client.Do(ctx, req, root)
+	return s.client.Do(withOperation(ctx, "LoadBalancersService.UpdateAssignedDevices"), req, root)
 }
 
 // CreateForwardingRule makes a new forwarding rule.
@@ -262,7 +311,7 @@ func (s *LoadBalancersService) CreateForwardingRule(ctx context.Context, loadBal
 	}
 
 	loadBalancerForwardingRuleRoot := new(loadBalancerForwardingRuleRoot)
-	resp, err := s.client.Do(ctx, req, loadBalancerForwardingRuleRoot)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersService.CreateForwardingRule"), req, loadBalancerForwardingRuleRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -289,7 +338,7 @@ func (s *LoadBalancersService) UpdateForwardingRule(ctx context.Context, loadBal
 	}
 
 	loadBalancerForwardingRuleRoot := new(loadBalancerForwardingRuleRoot)
-	resp, err := s.client.Do(ctx, req, loadBalancerForwardingRuleRoot)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersService.UpdateForwardingRule"), req, loadBalancerForwardingRuleRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -312,5 +361,5 @@ func (s *LoadBalancersService) DeleteForwardingRule(ctx context.Context, loadBal
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "LoadBalancersService.DeleteForwardingRule"), req, nil)
 }
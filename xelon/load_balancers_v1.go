@@ -68,7 +68,7 @@ func (s *LoadBalancersServiceV1) List(ctx context.Context, tenantID string) ([]L
 	}
 
 	var loadBalancers []LoadBalancerV1
-	resp, err := s.client.Do(ctx, req, &loadBalancers)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersServiceV1.List"), req, &loadBalancers)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -89,7 +89,7 @@ func (s *LoadBalancersServiceV1) Get(ctx context.Context, tenantID, localID stri
 	}
 
 	loadBalancer := new(LoadBalancerV1)
-	resp, err := s.client.Do(ctx, req, loadBalancer)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersServiceV1.Get"), req, loadBalancer)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -113,7 +113,7 @@ func (s *LoadBalancersServiceV1) Create(ctx context.Context, tenantID string, cr
 	}
 
 	apiResponse := new(APIResponse)
-	resp, err := s.client.Do(ctx, req, apiResponse)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersServiceV1.Create"), req, apiResponse)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -133,7 +133,7 @@ func (s *LoadBalancersServiceV1) Delete(ctx context.Context, tenantID, localID s
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "LoadBalancersServiceV1.Delete"), req, nil)
 }
 
 func (s *LoadBalancersServiceV1) UpdateForwardingRules(ctx context.Context, tenantID, localID string, updateRequest *LoadBalancerUpdateForwardingRulesRequestV1) (*APIResponse, *Response, error) {
@@ -151,7 +151,7 @@ func (s *LoadBalancersServiceV1) UpdateForwardingRules(ctx context.Context, tena
 	}
 
 	apiResponse := new(APIResponse)
-	resp, err := s.client.Do(ctx, req, apiResponse)
+	resp, err := s.client.Do(withOperation(ctx, "LoadBalancersServiceV1.UpdateForwardingRules"), req, apiResponse)
 	if err != nil {
 		return nil, resp, err
 	}
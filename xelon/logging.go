@@ -0,0 +1,116 @@
+package xelon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon/internal/redact"
+)
+
+// WithLogger configures Client to log every request with its method, sanitized URL,
+// response status and latency. Successful requests are logged at info level,
+// API errors at warn level and transport errors at error level.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(client *Client) {
+		client.logger = logger
+	}
+}
+
+// WithLogBodies configures Client to include request and response bodies in log records.
+// Bearer tokens, passwords and object storage secret keys are redacted automatically.
+// It has no effect unless a logger is configured with WithLogger.
+func WithLogBodies(enabled bool) ClientOption {
+	return func(client *Client) {
+		client.logBodies = enabled
+	}
+}
+
+// logRoundTrip logs a single attempt of req. If bodies are logged, the response body
+// is buffered and resp is returned with a body which can be read again.
+func (c *Client) logRoundTrip(ctx context.Context, req *http.Request, resp *http.Response, err error, latency time.Duration) *http.Response {
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", sanitizeURL(cloneURL(req.URL)).String()),
+		slog.Duration("latency", latency),
+	}
+
+	if c.logBodies {
+		if body := requestBody(req); len(body) > 0 {
+			attrs = append(attrs, slog.String("request_body", string(redact.JSON(body))))
+		}
+	}
+
+	if err != nil {
+		if urlErr, ok := errors.AsType[*url.Error](err); ok {
+			sanitized := *urlErr
+			if uri, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+				sanitized.URL = sanitizeURL(uri).String()
+			}
+			err = &sanitized
+		}
+		attrs = append(attrs, slog.Any("error", err))
+		c.logger.LogAttrs(ctx, slog.LevelError, "xelon: request failed", attrs...)
+		return resp
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	if requestID := parseRequestID(resp); requestID != "" {
+		attrs = append(attrs, slog.String("request_id", requestID))
+	}
+	if c.logBodies {
+		body, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if readErr != nil {
+			// surface the read error to the caller decoding the response
+			resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{readErr}))
+		}
+		if len(body) > 0 {
+			attrs = append(attrs, slog.String("response_body", string(redact.JSON(body))))
+		}
+	}
+
+	level := slog.LevelInfo
+	if resp.StatusCode >= 400 {
+		level = slog.LevelWarn
+	}
+	c.logger.LogAttrs(ctx, level, "xelon: request completed", attrs...)
+
+	return resp
+}
+
+// requestBody returns a copy of the request body without consuming it.
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+	data, _ := io.ReadAll(body)
+	return data
+}
+
+// cloneURL returns a copy of u, so it can be sanitized without modifying the request.
+func cloneURL(u *url.URL) *url.URL {
+	if u == nil {
+		return nil
+	}
+	clone := *u
+	return &clone
+}
+
+// errReader returns err on every read.
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
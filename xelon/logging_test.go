@@ -0,0 +1,107 @@
+package xelon
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogging_WithLogger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	WithLogger(slog.New(slog.NewJSONHandler(&buf, nil)))(client)
+
+	mux.HandleFunc("GET /tenants/current", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "request-1")
+		_, _ = w.Write([]byte(`{"identifier":"tenant-1"}`))
+	})
+
+	_, _, err := client.Tenants.GetCurrent(ctx)
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `"level":"INFO"`)
+	assert.Contains(t, buf.String(), `"msg":"xelon: request completed"`)
+	assert.Contains(t, buf.String(), `"method":"GET"`)
+	assert.Contains(t, buf.String(), `"status":200`)
+	assert.Contains(t, buf.String(), `"request_id":"request-1"`)
+	assert.Contains(t, buf.String(), `"latency"`)
+	assert.NotContains(t, buf.String(), "request_body")
+	assert.NotContains(t, buf.String(), "auth-token")
+}
+
+func TestLogging_WithLogBodies_redactsSecrets(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	WithLogger(slog.New(slog.NewJSONHandler(&buf, nil)))(client)
+	WithLogBodies(true)(client)
+
+	mux.HandleFunc("POST /devices", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"identifier":"device-1","hostName":"web-1"}}`))
+	})
+	mux.HandleFunc("POST /object-storages/users/user-1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"identifier":"token-1","accessKey":"ak_test","secretKey":"sk_test_secret"}}`))
+	})
+
+	device, _, err := client.Devices.Create(ctx, &DeviceCreateRequest{
+		HostName:             "web-1",
+		Password:             "device-secret",
+		PasswordConfirmation: "device-secret",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "device-1", device.ID)
+
+	token, _, err := client.ObjectStorages.CreateUserToken(ctx, "user-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "sk_test_secret", token.SecretKey)
+
+	assert.Contains(t, buf.String(), `request_body`)
+	assert.Contains(t, buf.String(), `response_body`)
+	assert.Contains(t, buf.String(), `web-1`)
+	assert.Contains(t, buf.String(), `ak_test`)
+	assert.NotContains(t, buf.String(), "device-secret")
+	assert.NotContains(t, buf.String(), "sk_test_secret")
+}
+
+func TestLogging_WithLogger_errorResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	WithLogger(slog.New(slog.NewJSONHandler(&buf, nil)))(client)
+
+	mux.HandleFunc("GET /devices/unknown", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := client.Devices.Get(ctx, "unknown")
+
+	assert.True(t, IsNotFound(err))
+	assert.Contains(t, buf.String(), `"level":"WARN"`)
+	assert.Contains(t, buf.String(), `"status":404`)
+}
+
+func TestLogging_sanitizesURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var buf bytes.Buffer
+	WithLogger(slog.New(slog.NewJSONHandler(&buf, nil)))(client)
+
+	mux.HandleFunc("GET /testing", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.URL.Query().Get("password"))
+	})
+
+	req, _ := client.NewRequest(http.MethodGet, "testing?password=secret", nil)
+	_, err := client.Do(ctx, req, nil)
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "password=REDACTED")
+	assert.NotContains(t, buf.String(), "secret")
+}
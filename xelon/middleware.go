@@ -0,0 +1,90 @@
+package xelon
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Call describes a single API call passing through the middleware chain.
+type Call struct {
+	// Service is the name of the service which issued the call, e.g. "DevicesService".
+	Service string
+
+	// Operation is the name of the service method which issued the call,
+	// e.g. "DevicesService.Create". It is empty if the call was not issued by a service.
+	Operation string
+
+	// ResourceID is the best-effort id of the addressed resource taken from the request path.
+	ResourceID string
+
+	// Request is the outgoing request. Middlewares may add headers, e.g. to propagate trace context.
+	Request *http.Request
+
+	// Attempts is the number of attempts made including retries. It is set once the next handler returns.
+	Attempts int
+}
+
+// Handler sends an API call and returns its response.
+type Handler func(ctx context.Context, call *Call) (*Response, error)
+
+// Middleware intercepts every API call sent by Client.Do, e.g. to start a tracing span
+// or record metrics. The middleware must call next to continue the call.
+type Middleware interface {
+	Wrap(next Handler) Handler
+}
+
+// MiddlewareFunc is an adapter to allow the use of ordinary functions as Middleware.
+type MiddlewareFunc func(next Handler) Handler
+
+// Wrap calls f(next).
+func (f MiddlewareFunc) Wrap(next Handler) Handler { return f(next) }
+
+// WithMiddleware configures Client to pass every API call through middlewares.
+// The first middleware is the outermost one and sees the call before all others.
+// The middlewares wrap the whole call including retries.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(client *Client) {
+		client.middlewares = append(client.middlewares, middlewares...)
+	}
+}
+
+type operationContextKey struct{}
+
+// withOperation returns a copy of ctx carrying the operation name. Service methods
+// set it for every call, e.g. withOperation(ctx, "DevicesService.Create").
+func withOperation(ctx context.Context, operation string) context.Context {
+	if operation == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, operationContextKey{}, operation)
+}
+
+// newCall creates a Call for req.
+func (c *Client) newCall(ctx context.Context, req *http.Request) *Call {
+	operation, _ := ctx.Value(operationContextKey{}).(string)
+	service, _, _ := strings.Cut(operation, ".")
+
+	return &Call{
+		Service:    service,
+		Operation:  operation,
+		ResourceID: resourceID(c.baseURL.Path, req.URL.Path),
+		Request:    req,
+	}
+}
+
+// collectionSegment matches path segments naming a collection or an action, e.g. "virtual-ips".
+var collectionSegment = regexp.MustCompile(`^[a-z-]+$`)
+
+// resourceID returns the last segment of path (relative to basePath) which looks like an id.
+func resourceID(basePath, path string) string {
+	path = strings.TrimPrefix(path, basePath)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := len(segments) - 1; i > 0; i-- {
+		if segments[i] != "" && !collectionSegment.MatchString(segments[i]) {
+			return segments[i]
+		}
+	}
+	return ""
+}
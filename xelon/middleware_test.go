@@ -0,0 +1,144 @@
+package xelon
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func recordingMiddleware(calls *[]Call) Middleware {
+	return MiddlewareFunc(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*Response, error) {
+			call.Request.Header.Set("Traceparent", "00-trace-span-01")
+			resp, err := next(ctx, call)
+			*calls = append(*calls, *call)
+			return resp, err
+		}
+	})
+}
+
+func TestMiddleware_WithMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []Call
+	WithMiddleware(recordingMiddleware(&calls))(client)
+
+	mux.HandleFunc("POST /devices/0123456789ab/start", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "00-trace-span-01", r.Header.Get("Traceparent"))
+	})
+
+	_, err := client.Devices.Start(ctx, "0123456789ab")
+
+	assert.NoError(t, err)
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, "DevicesService", calls[0].Service)
+		assert.Equal(t, "DevicesService.Start", calls[0].Operation)
+		assert.Equal(t, "0123456789ab", calls[0].ResourceID)
+		assert.Equal(t, 1, calls[0].Attempts)
+	}
+}
+
+func TestMiddleware_order(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var order []string
+	named := func(name string) Middleware {
+		return MiddlewareFunc(func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) (*Response, error) {
+				order = append(order, name+" before")
+				resp, err := next(ctx, call)
+				order = append(order, name+" after")
+				return resp, err
+			}
+		})
+	}
+	WithMiddleware(named("outer"), named("inner"))(client)
+
+	mux.HandleFunc("GET /tenants/current", func(w http.ResponseWriter, r *http.Request) {})
+
+	_, _, err := client.Tenants.GetCurrent(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"outer before", "inner before", "inner after", "outer after"}, order)
+}
+
+func TestMiddleware_recordsAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []Call
+	WithMiddleware(recordingMiddleware(&calls))(client)
+	WithRetryPolicy(RetryPolicy{
+		MaxAttempts:          3,
+		MinBackoff:           time.Millisecond,
+		MaxBackoff:           time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	})(client)
+
+	var requests int
+	mux.HandleFunc("GET /kubernetes/cluster-1", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"identifier":"cluster-1"}}`))
+	})
+
+	_, _, err := client.Kubernetes.Get(ctx, "cluster-1")
+
+	assert.NoError(t, err)
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, "KubernetesService.Get", calls[0].Operation)
+		assert.Equal(t, "cluster-1", calls[0].ResourceID)
+		assert.Equal(t, 2, calls[0].Attempts)
+	}
+}
+
+func TestMiddleware_paginatorOperation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []Call
+	WithMiddleware(recordingMiddleware(&calls))(client)
+
+	mux.HandleFunc("GET /devices", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"identifier":"device-1"}],"meta":{"currentPage":1,"lastPage":1}}`))
+	})
+
+	seq, errFn := client.Devices.All(ctx, nil)
+	for range seq {
+	}
+
+	assert.NoError(t, errFn())
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, "DevicesService.All", calls[0].Operation)
+		assert.Empty(t, calls[0].ResourceID)
+	}
+}
+
+func TestMiddleware_resourceID(t *testing.T) {
+	type testCase struct {
+		path     string
+		expected string
+	}
+	tests := map[string]testCase{
+		"collection":         {path: "/api/v2/devices", expected: ""},
+		"resource":           {path: "/api/v2/devices/0123456789ab", expected: "0123456789ab"},
+		"resource action":    {path: "/api/v2/devices/0123456789ab/start", expected: "0123456789ab"},
+		"nested resource":    {path: "/api/v2/devices/0123456789ab/snapshots/42", expected: "42"},
+		"named sub resource": {path: "/api/v2/tenants/current", expected: ""},
+		"nested collection":  {path: "/api/v2/object-storages/users", expected: ""},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, resourceID("/api/v2/", test.path))
+		})
+	}
+}
@@ -13,6 +13,23 @@ const networkBasePath = "networks"
 // NetworksService handles communication with the network related methods of the Xelon REST API.
 type NetworksService service
 
+// NetworksAPI is the interface implemented by NetworksService.
+type NetworksAPI interface {
+	List(ctx context.Context, opts *NetworkListOptions) ([]Network, *Response, error)
+	All(ctx context.Context, opts *NetworkListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Network, *Response], func() error)
+	ListShared(ctx context.Context, cloudID, tenantID string) ([]Network, *Response, error)
+	Get(ctx context.Context, networkID string) (*Network, *Response, error)
+	CreateLAN(ctx context.Context, createRequest *NetworkLANCreateRequest) (*Network, *Response, error)
+	UpdateLAN(ctx context.Context, networkID string, updateRequest *NetworkLANUpdateRequest) (*Network, *Response, error)
+	CreateWAN(ctx context.Context, createRequest *NetworkWANCreateRequest) (*Network, *Response, error)
+	UpdateWAN(ctx context.Context, networkID string, updateRequest *NetworkWANUpdateRequest) (*Network, *Response, error)
+	Delete(ctx context.Context, networkID string) (*Response, error)
+	ShareNetworks(ctx context.Context, shareRequest *NetworkShareRequest) (*Response, error)
+	UnshareNetwork(ctx context.Context, networkID, tenantID string) (*Response, error)
+}
+
+var _ NetworksAPI = (*NetworksService)(nil)
+
 type Network struct {
 	AssignedTenants []Tenant           `json:"assigned,omitempty"`
 	Clouds          []Cloud            `json:"clouds,omitempty"`
@@ -88,10 +105,30 @@ type NetworkWANUpdateRequest struct {
 	NetworkSpeed int    `json:"networkSpeedValue"`
 }
 
+// NetworkSort is a field networks can be sorted by, use SortDesc for descending order.
+type NetworkSort string
+
+const (
+	NetworkSortName        NetworkSort = "name"
+	NetworkSortType        NetworkSort = "type"
+	NetworkSortNetworkSize NetworkSort = "networkSize"
+)
+
+func (NetworkSort) sortFields() []string {
+	return sortFieldNames(
+		NetworkSortName,
+		NetworkSortType,
+		NetworkSortNetworkSize,
+	)
+}
+
 // NetworkListOptions specifies the optional parameters to the NetworksService.List.
 type NetworkListOptions struct {
-	Sort   string `url:"sort,omitempty"`
-	Search string `url:"search,omitempty"`
+	Sort   NetworkSort `url:"sort,omitempty"`
+	Search string      `url:"search,omitempty"`
+
+	// CloudID filters the list by cloud.
+	CloudID string `url:"cloudIdentifier,omitempty"`
 
 	ListOptions
 }
@@ -110,7 +147,7 @@ func (v Network) String() string { return Stringify(v) }
 
 // List provides a list of all networks.
 func (s *NetworksService) List(ctx context.Context, opts *NetworkListOptions) ([]Network, *Response, error) {
-	path, err := addOptions(networkBasePath, opts)
+	path, err := s.client.addListOptions(networkBasePath, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -121,7 +158,7 @@ func (s *NetworksService) List(ctx context.Context, opts *NetworkListOptions) ([
 	}
 
 	root := new(networksRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -135,8 +172,8 @@ func (s *NetworksService) List(ctx context.Context, opts *NetworkListOptions) ([
 // All returns an iterator to paginate over all available networks.
 //
 // The return iterator can be used in a for...range loop to easily process all networks.
-func (s *NetworksService) All(ctx context.Context, opts *ListOptions) (iter.Seq2[Network, *Response], func() error) {
-	return newPaginator[Network](ctx, s.client, networkBasePath, opts)
+func (s *NetworksService) All(ctx context.Context, opts *NetworkListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Network, *Response], func() error) {
+	return newPaginator[Network](withOperation(ctx, "NetworksService.All"), s.client, networkBasePath, opts, paginatorOpts...)
 }
 
 // ListShared provides a list of all shared networks.
@@ -155,7 +192,7 @@ func (s *NetworksService) ListShared(ctx context.Context, cloudID, tenantID stri
 	}
 
 	var networks []Network
-	resp, err := s.client.Do(ctx, req, &networks)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksService.ListShared"), req, &networks)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -176,7 +213,7 @@ func (s *NetworksService) Get(ctx context.Context, networkID string) (*Network,
 	}
 
 	network := new(Network)
-	resp, err := s.client.Do(ctx, req, network)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksService.Get"), req, network)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -197,7 +234,7 @@ func (s *NetworksService) CreateLAN(ctx context.Context, createRequest *NetworkL
 	}
 
 	networkRoot := new(networkRoot)
-	resp, err := s.client.Do(ctx, req, networkRoot)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksService.CreateLAN"), req, networkRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -221,7 +258,7 @@ func (s *NetworksService) UpdateLAN(ctx context.Context, networkID string, updat
 	}
 
 	network := new(Network)
-	resp, err := s.client.Do(ctx, req, network)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksService.UpdateLAN"), req, network)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -242,7 +279,7 @@ func (s *NetworksService) CreateWAN(ctx context.Context, createRequest *NetworkW
 	}
 
 	networkRoot := new(networkRoot)
-	resp, err := s.client.Do(ctx, req, networkRoot)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksService.CreateWAN"), req, networkRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -266,7 +303,7 @@ func (s *NetworksService) UpdateWAN(ctx context.Context, networkID string, updat
 	}
 
 	network := new(Network)
-	resp, err := s.client.Do(ctx, req, network)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksService.UpdateWAN"), req, network)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -286,7 +323,7 @@ func (s *NetworksService) Delete(ctx context.Context, networkID string) (*Respon
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "NetworksService.Delete"), req, nil)
 }
 
 // ShareNetworks shares networks between tenants.
@@ -302,7 +339,7 @@ func (s *NetworksService) ShareNetworks(ctx context.Context, shareRequest *Netwo
 	}
 
 	networkRoot := new(networkRoot)
-	return s.client.Do(ctx, req, networkRoot)
+	return s.client.Do(withOperation(ctx, "NetworksService.ShareNetworks"), req, networkRoot)
 }
 
 // UnshareNetwork removes shared network from the tenant.
@@ -325,5 +362,5 @@ func (s *NetworksService) UnshareNetwork(ctx context.Context, networkID, tenantI
 	}
 
 	networkRoot := new(networkRoot)
-	return s.client.Do(ctx, req, networkRoot)
+	return s.client.Do(withOperation(ctx, "NetworksService.UnshareNetwork"), req, networkRoot)
 }
@@ -118,7 +118,7 @@ func (s *NetworksServiceV1) List(ctx context.Context, tenantID string) ([]Networ
 	}
 
 	networkRoot := new(networkRootV1)
-	resp, err := s.client.Do(ctx, req, networkRoot)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksServiceV1.List"), req, networkRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -140,7 +140,7 @@ func (s *NetworksServiceV1) Get(ctx context.Context, tenantID string, networkID
 	}
 
 	networkInfo := new(NetworkInfo)
-	resp, err := s.client.Do(ctx, req, networkInfo)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksServiceV1.Get"), req, networkInfo)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -165,7 +165,7 @@ func (s *NetworksServiceV1) CreateLAN(ctx context.Context, tenantID string, crea
 	}
 
 	apiResponse := new(APIResponse)
-	resp, err := s.client.Do(ctx, req, apiResponse)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksServiceV1.CreateLAN"), req, apiResponse)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -187,7 +187,7 @@ func (s *NetworksServiceV1) Update(ctx context.Context, networkID int, updateReq
 	}
 
 	apiResponse := new(APIResponse)
-	resp, err := s.client.Do(ctx, req, apiResponse)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksServiceV1.Update"), req, apiResponse)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -204,7 +204,7 @@ func (s *NetworksServiceV1) Delete(ctx context.Context, networkID int) (*Respons
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "NetworksServiceV1.Delete"), req, nil)
 }
 
 // AddIPAddress adds a new IP address to the specific network.
@@ -221,7 +221,7 @@ func (s *NetworksServiceV1) AddIPAddress(ctx context.Context, networkID int, add
 	}
 
 	apiResponse := new(APIResponse)
-	resp, err := s.client.Do(ctx, req, apiResponse)
+	resp, err := s.client.Do(withOperation(ctx, "NetworksServiceV1.AddIPAddress"), req, apiResponse)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -238,5 +238,5 @@ func (s *NetworksServiceV1) DeleteIPAddress(ctx context.Context, networkID, ipAd
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "NetworksServiceV1.DeleteIPAddress"), req, nil)
 }
@@ -14,6 +14,31 @@ const objectStorageBasePath = "object-storages"
 // ObjectStoragesService handles communication with the object storage related methods of the Xelon API.
 type ObjectStoragesService service
 
+// ObjectStoragesAPI is the interface implemented by ObjectStoragesService.
+type ObjectStoragesAPI interface {
+	ListUsers(ctx context.Context, opts *ObjectStorageUserListOptions) ([]ObjectStorageUser, *Response, error)
+	AllUsers(ctx context.Context, opts *ObjectStorageUserListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[ObjectStorageUser, *Response], func() error)
+	GetUser(ctx context.Context, objectStorageUserID string) (*ObjectStorageUser, *Response, error)
+	CreateUser(ctx context.Context, createRequest *ObjectStorageUserCreateRequest) (*ObjectStorageUser, *Response, error)
+	UpdateUser(ctx context.Context, objectStorageUserID string, updateRequest *ObjectStorageUserUpdateRequest) (*ObjectStorageUser, *Response, error)
+	DeleteUser(ctx context.Context, objectStorageUserID string) (*Response, error)
+	CreateUserToken(ctx context.Context, objectStorageUserID string) (*ObjectStorageUserToken, *Response, error)
+	DeleteUserToken(ctx context.Context, objectStorageUserID, objectStorageUserTokenID string) (*Response, error)
+	ListBuckets(ctx context.Context, opts *ObjectStorageBucketListOptions) ([]ObjectStorageBucket, *Response, error)
+	AllBuckets(ctx context.Context, opts *ObjectStorageBucketListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[ObjectStorageBucket, *Response], func() error)
+	GetBucket(ctx context.Context, bucketName, objectStorageUserID string) (*ObjectStorageBucket, *Response, error)
+	CreateBucket(ctx context.Context, createRequest *ObjectStorageBucketCreateRequest) (*ObjectStorageBucket, *Response, error)
+	UpdateBucket(ctx context.Context, bucketID string, updateRequest *ObjectStorageBucketUpdateRequest) (*Response, error)
+	DeleteBucket(ctx context.Context, bucketName, objectStorageUserID string) (*Response, error)
+	UpdateBucketVersioning(ctx context.Context, bucketName, objectStorageUserID string, updateRequest *ObjectStorageBucketVersioningUpdateRequest) (*Response, error)
+	GetBucketIPRestrictions(ctx context.Context, bucketName, objectStorageUserID string) (*ObjectStorageBucketIPRestrictions, *Response, error)
+	UpdateBucketIPRestrictions(ctx context.Context, bucketName, objectStorageUserID string, updateRequest *ObjectStorageBucketIPRestrictionsUpdateRequest) (*Response, error)
+	ListPlans(ctx context.Context) ([]ObjectStoragePlan, *Response, error)
+	ListRegions(ctx context.Context) ([]ObjectStorageRegion, *Response, error)
+}
+
+var _ ObjectStoragesAPI = (*ObjectStoragesService)(nil)
+
 // ObjectStorageUser represents a Xelon users for S3-compatible object storage.
 type ObjectStorageUser struct {
 	ID                       string                   `json:"identifier,omitempty"`
@@ -69,7 +94,7 @@ func (s *ObjectStoragesService) ListUsers(ctx context.Context, opts *ObjectStora
 	}
 
 	root := new(objectStorageUsersRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.ListUsers"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -83,8 +108,8 @@ func (s *ObjectStoragesService) ListUsers(ctx context.Context, opts *ObjectStora
 // AllUsers returns an iterator to paginate over all object storage users.
 //
 // The return iterator can be used in a for...range loop to easily process all users.
-func (s *ObjectStoragesService) AllUsers(ctx context.Context, opts *ListOptions) (iter.Seq2[ObjectStorageUser, *Response], func() error) {
-	return newPaginator[ObjectStorageUser](ctx, s.client, fmt.Sprintf("%v/users", objectStorageBasePath), opts)
+func (s *ObjectStoragesService) AllUsers(ctx context.Context, opts *ObjectStorageUserListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[ObjectStorageUser, *Response], func() error) {
+	return newPaginator[ObjectStorageUser](withOperation(ctx, "ObjectStoragesService.AllUsers"), s.client, fmt.Sprintf("%v/users", objectStorageBasePath), opts, paginatorOpts...)
 }
 
 // GetUser provides detailed information for object storage user identified by id.
@@ -100,7 +125,7 @@ func (s *ObjectStoragesService) GetUser(ctx context.Context, objectStorageUserID
 	}
 
 	user := new(ObjectStorageUser)
-	resp, err := s.client.Do(ctx, req, user)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.GetUser"), req, user)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -121,7 +146,7 @@ func (s *ObjectStoragesService) CreateUser(ctx context.Context, createRequest *O
 	}
 
 	root := new(objectStorageUserRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.CreateUser"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -148,7 +173,7 @@ func (s *ObjectStoragesService) UpdateUser(ctx context.Context, objectStorageUse
 	}
 
 	root := new(objectStorageUserRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.UpdateUser"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -171,7 +196,7 @@ func (s *ObjectStoragesService) DeleteUser(ctx context.Context, objectStorageUse
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "ObjectStoragesService.DeleteUser"), req, nil)
 }
 
 // ObjectStorageUserToken represents a Xelon object storage user token.
@@ -203,7 +228,7 @@ func (s *ObjectStoragesService) CreateUserToken(ctx context.Context, objectStora
 	}
 
 	root := new(objectStorageUserTokenRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.CreateUserToken"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -229,7 +254,7 @@ func (s *ObjectStoragesService) DeleteUserToken(ctx context.Context, objectStora
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "ObjectStoragesService.DeleteUserToken"), req, nil)
 }
 
 // ObjectStorageBucket represents a Xelon bucket for S3-compatible object storage.
@@ -312,7 +337,7 @@ func (s *ObjectStoragesService) ListBuckets(ctx context.Context, opts *ObjectSto
 	}
 
 	root := new(objectStorageBucketsRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.ListBuckets"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -326,8 +351,8 @@ func (s *ObjectStoragesService) ListBuckets(ctx context.Context, opts *ObjectSto
 // AllBuckets returns an iterator to paginate over all object storage buckets.
 //
 // The return iterator can be used in a for...range loop to easily process all buckets.
-func (s *ObjectStoragesService) AllBuckets(ctx context.Context, opts *ListOptions) (iter.Seq2[ObjectStorageBucket, *Response], func() error) {
-	return newPaginator[ObjectStorageBucket](ctx, s.client, fmt.Sprintf("%v/buckets", objectStorageBasePath), opts)
+func (s *ObjectStoragesService) AllBuckets(ctx context.Context, opts *ObjectStorageBucketListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[ObjectStorageBucket, *Response], func() error) {
+	return newPaginator[ObjectStorageBucket](withOperation(ctx, "ObjectStoragesService.AllBuckets"), s.client, fmt.Sprintf("%v/buckets", objectStorageBasePath), opts, paginatorOpts...)
 }
 
 // GetBucket provides detailed information for object storage bucket identified by name and user id.
@@ -346,7 +371,7 @@ func (s *ObjectStoragesService) GetBucket(ctx context.Context, bucketName, objec
 	}
 
 	root := new(objectStorageBucketRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.GetBucket"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -370,7 +395,7 @@ func (s *ObjectStoragesService) CreateBucket(ctx context.Context, createRequest
 	}
 
 	root := new(objectStorageBucketRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.CreateBucket"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -396,7 +421,7 @@ func (s *ObjectStoragesService) UpdateBucket(ctx context.Context, bucketID strin
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "ObjectStoragesService.UpdateBucket"), req, nil)
 }
 
 // DeleteBucket removes object storage bucket identified by name and user id.
@@ -414,7 +439,7 @@ func (s *ObjectStoragesService) DeleteBucket(ctx context.Context, bucketName, ob
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "ObjectStoragesService.DeleteBucket"), req, nil)
 }
 
 // UpdateBucketVersioning changes versioning for an object storage bucket.
@@ -435,7 +460,7 @@ func (s *ObjectStoragesService) UpdateBucketVersioning(ctx context.Context, buck
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "ObjectStoragesService.UpdateBucketVersioning"), req, nil)
 }
 
 // GetBucketIPRestrictions provides IP restriction settings for an object storage bucket.
@@ -454,7 +479,7 @@ func (s *ObjectStoragesService) GetBucketIPRestrictions(ctx context.Context, buc
 	}
 
 	root := new(objectStorageBucketIPRestrictionsRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.GetBucketIPRestrictions"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -483,7 +508,7 @@ func (s *ObjectStoragesService) UpdateBucketIPRestrictions(ctx context.Context,
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "ObjectStoragesService.UpdateBucketIPRestrictions"), req, nil)
 }
 
 // ObjectStoragePlan represents a Xelon object storage pricing plan.
@@ -506,7 +531,7 @@ func (s *ObjectStoragesService) ListPlans(ctx context.Context) ([]ObjectStorageP
 	}
 
 	var plans []ObjectStoragePlan
-	resp, err := s.client.Do(ctx, req, &plans)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.ListPlans"), req, &plans)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -532,7 +557,7 @@ func (s *ObjectStoragesService) ListRegions(ctx context.Context) ([]ObjectStorag
 	}
 
 	var regions []ObjectStorageRegion
-	resp, err := s.client.Do(ctx, req, &regions)
+	resp, err := s.client.Do(withOperation(ctx, "ObjectStoragesService.ListRegions"), req, &regions)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -316,7 +316,7 @@ func TestObjectStorages_AllBuckets(t *testing.T) {
 		}
 	})
 
-	seq, errFn := client.ObjectStorages.AllBuckets(ctx, &ListOptions{PerPage: 1})
+	seq, errFn := client.ObjectStorages.AllBuckets(ctx, &ObjectStorageBucketListOptions{ListOptions: ListOptions{PerPage: 1}})
 
 	var actualBuckets []ObjectStorageBucket
 	for bucket := range seq {
@@ -0,0 +1,123 @@
+// Package otelxelon provides an OpenTelemetry tracing middleware for the Xelon client.
+//
+// The middleware starts a client span for every API call:
+//
+//	client := xelon.NewClient("my-secret-token",
+//		xelon.WithMiddleware(otelxelon.Middleware()),
+//	)
+package otelxelon
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+const instrumentationName = "github.com/Xelon-AG/xelon-sdk-go/xelon/otelxelon"
+
+// Attribute keys set on every span in addition to the HTTP semantic conventions.
+const (
+	ServiceKey    = attribute.Key("xelon.service")
+	OperationKey  = attribute.Key("xelon.operation")
+	ResourceIDKey = attribute.Key("xelon.resource_id")
+	RetryCountKey = attribute.Key("xelon.retry_count")
+	RequestIDKey  = attribute.Key("xelon.request_id")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	propagators    propagation.TextMapPropagator
+}
+
+// Option configures the tracing middleware.
+type Option func(*config)
+
+// WithTracerProvider configures the middleware to use a specific tracer provider.
+// By default, the global tracer provider is used.
+func WithTracerProvider(tracerProvider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tracerProvider
+	}
+}
+
+// WithPropagators configures the middleware to inject trace context into request headers
+// with specific propagators. By default, the global propagators are used.
+func WithPropagators(propagators propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagators = propagators
+	}
+}
+
+// Middleware returns a xelon.Middleware which starts a span for every API call.
+func Middleware(opts ...Option) xelon.Middleware {
+	c := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		propagators:    otel.GetTextMapPropagator(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	tracer := c.tracerProvider.Tracer(instrumentationName)
+
+	return xelon.MiddlewareFunc(func(next xelon.Handler) xelon.Handler {
+		return func(ctx context.Context, call *xelon.Call) (*xelon.Response, error) {
+			ctx, span := tracer.Start(ctx, spanName(call),
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(startAttributes(call)...),
+			)
+			defer span.End()
+
+			c.propagators.Inject(ctx, propagation.HeaderCarrier(call.Request.Header))
+
+			resp, err := next(ctx, call)
+
+			span.SetAttributes(RetryCountKey.Int(max(call.Attempts-1, 0)))
+			if resp != nil && resp.Response != nil {
+				span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+				if resp.RequestID != "" {
+					span.SetAttributes(RequestIDKey.String(resp.RequestID))
+				}
+			}
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return resp, err
+		}
+	})
+}
+
+// spanName returns the operation name, or the HTTP method if the call was not issued by a service.
+func spanName(call *xelon.Call) string {
+	if call.Operation != "" {
+		return call.Operation
+	}
+	return "xelon " + call.Request.Method
+}
+
+// startAttributes returns the span attributes known before the call is sent.
+// The query string is omitted since it may contain secrets.
+func startAttributes(call *xelon.Call) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", call.Request.Method),
+		attribute.String("server.address", call.Request.URL.Hostname()),
+		attribute.String("url.path", call.Request.URL.Path),
+	}
+	if call.Service != "" {
+		attrs = append(attrs, ServiceKey.String(call.Service))
+	}
+	if call.Operation != "" {
+		attrs = append(attrs, OperationKey.String(call.Operation))
+	}
+	if call.ResourceID != "" {
+		attrs = append(attrs, ResourceIDKey.String(call.ResourceID))
+	}
+	return attrs
+}
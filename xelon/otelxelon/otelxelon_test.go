@@ -0,0 +1,66 @@
+package otelxelon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func TestMiddleware(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("POST /devices/0123456789ab/start", func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(t, r.Header.Get("Traceparent"))
+		w.Header().Set("X-Request-Id", "request-1")
+	})
+	mux.HandleFunc("GET /devices/unknown", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	client := xelon.NewClient("auth-token",
+		xelon.WithBaseURL(fmt.Sprintf("%v/", server.URL)),
+		xelon.WithMiddleware(Middleware(
+			WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+			WithPropagators(propagation.TraceContext{}),
+		)),
+	)
+
+	_, err := client.Devices.Start(context.Background(), "0123456789ab")
+	require.NoError(t, err)
+	_, _, err = client.Devices.Get(context.Background(), "unknown")
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "DevicesService.Start", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Subset(t, spans[0].Attributes(), []attribute.KeyValue{
+		ServiceKey.String("DevicesService"),
+		OperationKey.String("DevicesService.Start"),
+		ResourceIDKey.String("0123456789ab"),
+		RetryCountKey.Int(0),
+		RequestIDKey.String("request-1"),
+		attribute.String("http.request.method", http.MethodPost),
+		attribute.Int("http.response.status_code", http.StatusOK),
+	})
+
+	assert.Equal(t, "DevicesService.Get", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Contains(t, spans[1].Attributes(), attribute.Int("http.response.status_code", http.StatusNotFound))
+}
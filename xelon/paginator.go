@@ -2,9 +2,17 @@ package xelon
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"iter"
+	"maps"
 	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/google/go-querystring/query"
 )
 
 // paginatedResponse is a generic "container" used to unmarshal any paginated list response
@@ -15,65 +23,331 @@ type paginatedResponse[T any] struct {
 	Meta *Meta `json:"meta"`
 }
 
-func newPaginator[T any](ctx context.Context, client *Client, pathURL string, opts *ListOptions) (iter.Seq2[T, *Response], func() error) {
+// ErrListShifted is returned by the error function of an iterator if the list changed
+// during the iteration, i.e. the total number of items changed or the last item of a
+// resumed iteration was not found. The iteration is not stopped, but items may have
+// been skipped or yielded twice.
+var ErrListShifted = errors.New("list changed during iteration")
+
+// PaginatorOption configures the iterators returned by the All methods.
+type PaginatorOption func(*paginatorConfig)
+
+type paginatorConfig struct {
+	prefetch   int         // Number of pages fetched concurrently, 1 or less fetches one page after another.
+	checkpoint *Checkpoint // Position of the iteration, updated for every yielded item.
+}
+
+// WithPrefetch configures the iterator to fetch up to workers pages concurrently once
+// the number of pages is known from the first page. Items are yielded in order anyway.
+// If the consumer stops the iteration early, requests in flight are canceled.
+func WithPrefetch(workers int) PaginatorOption {
+	return func(config *paginatorConfig) {
+		config.prefetch = workers
+	}
+}
+
+// WithCheckpoint configures the iterator to keep checkpoint updated with the position of
+// the iteration. If checkpoint was saved by an earlier iteration, the iteration resumes
+// after the last item yielded then. The filters of the options must not change.
+func WithCheckpoint(checkpoint *Checkpoint) PaginatorOption {
+	return func(config *paginatorConfig) {
+		config.checkpoint = checkpoint
+	}
+}
+
+// Checkpoint is the position of an iteration over a paginated list. It can be persisted,
+// e.g. as JSON, to resume an interrupted iteration with WithCheckpoint.
+//
+// The checkpoint is updated before every item is yielded, so a checkpoint persisted in
+// the loop body includes the current item and a resumed iteration continues after it.
+// Persist it once the item has been processed, otherwise the item is skipped if the
+// process is interrupted.
+type Checkpoint struct {
+	// Page is the page of LastItemID, or the next page if LastItemID is empty.
+	Page int `json:"page"`
+
+	// PerPage is the number of items per page, resumed iterations use the same.
+	PerPage int `json:"perPage"`
+
+	// Filters are the query parameters of the list options except for the pagination.
+	Filters url.Values `json:"filters,omitempty"`
+
+	// LastItemID is the id of the last yielded item.
+	LastItemID string `json:"lastItemId,omitempty"`
+
+	// Total is the total number of items reported by the first page of the iteration.
+	Total int `json:"total"`
+
+	// Shifted reports whether the list changed during the last run of the iteration,
+	// see ErrListShifted. It is reset when the iteration is resumed.
+	Shifted bool `json:"shifted,omitempty"`
+}
+
+func (v Checkpoint) String() string { return Stringify(v) }
+
+// listOptions is implemented by ListOptions and all option structs embedding it.
+type listOptions interface {
+	listOptions() *ListOptions
+}
+
+func (o *ListOptions) listOptions() *ListOptions { return o }
+
+// newPaginator returns an iterator over all items of the paginated list at pathURL.
+// opts may be any options struct embedding ListOptions, so the iteration can be
+// combined with filters like search and sort. opts is copied and never modified.
+func newPaginator[T any, O any, PO interface {
+	*O
+	listOptions
+}](ctx context.Context, client *Client, pathURL string, opts PO, paginatorOpts ...PaginatorOption) (iter.Seq2[T, *Response], func() error) {
+	config := new(paginatorConfig)
+	for _, opt := range paginatorOpts {
+		opt(config)
+	}
+
 	var iterErr error
 	seq := func(yield func(item T, resp *Response) bool) {
-		if opts == nil {
-			opts = &ListOptions{}
+		iterErr = nil
+		shifted := false
+		defer func() {
+			if shifted && iterErr == nil {
+				iterErr = ErrListShifted
+			}
+		}()
+
+		// every iteration starts with its own copy of the options
+		current := PO(new(O))
+		if opts != nil {
+			*current = *opts
 		}
-		if opts.Page == 0 {
-			opts.Page = 1
+		pagination := current.listOptions()
+		if pagination.Page == 0 {
+			pagination.Page = 1
 		}
-		if opts.PerPage == 0 {
-			opts.PerPage = 10
+		if pagination.PerPage == 0 {
+			pagination.PerPage = 10
 		}
 
-		for {
-			select {
-			// if the context has been canceled, the context's error is more useful
-			case <-ctx.Done():
-				iterErr = ctx.Err()
+		checkpoint, resumed := config.checkpoint, false
+		var resumeAfter string
+		var total int
+		if checkpoint != nil {
+			filters, err := listFilters(current)
+			if err != nil {
+				iterErr = fmt.Errorf("failed to construct URL with options: %w", err)
 				return
-			default:
 			}
+			if checkpoint.Page > 0 {
+				if !maps.EqualFunc(checkpoint.Filters, filters, slices.Equal) {
+					iterErr = fmt.Errorf("checkpoint filters %v do not match the options %v", checkpoint.Filters.Encode(), filters.Encode())
+					return
+				}
+				resumed, resumeAfter, total = true, checkpoint.LastItemID, checkpoint.Total
+				pagination.Page = checkpoint.Page
+				if checkpoint.PerPage > 0 {
+					pagination.PerPage = checkpoint.PerPage
+				}
+			}
+			checkpoint.Page, checkpoint.PerPage, checkpoint.Filters = pagination.Page, pagination.PerPage, filters
+			checkpoint.Shifted = false
+		}
+
+		// markShifted records that the list changed during the iteration
+		markShifted := func() {
+			shifted = true
+			if checkpoint != nil {
+				checkpoint.Shifted = true
+			}
+		}
+
+		// yieldPage yields the items of the page with the given number and keeps the
+		// checkpoint updated. It reports whether the iteration should continue.
+		firstPage := true
+		yieldPage := func(number int, items []T, resp *Response) bool {
+			if resp.Meta != nil {
+				if firstPage && !resumed {
+					total = resp.Meta.Total
+					if checkpoint != nil {
+						checkpoint.Total = total
+					}
+				} else if resp.Meta.Total != total {
+					markShifted()
+				}
+			}
+			firstPage = false
+
+			if resumeAfter != "" {
+				// skip the items yielded before the iteration was interrupted
+				index := slices.IndexFunc(items, func(item T) bool { return itemID(item) == resumeAfter })
+				if index >= 0 {
+					items = items[index+1:]
+				} else {
+					markShifted()
+				}
+				resumeAfter = ""
+			}
+
+			for _, item := range items {
+				// the checkpoint is updated first, so it includes the item in the loop body
+				if checkpoint != nil {
+					checkpoint.Page, checkpoint.LastItemID = number, itemID(item)
+				}
+				if !yield(item, resp) {
+					// stop iteration if the consumer stops
+					return false
+				}
+			}
+
+			if checkpoint != nil && resp.Meta != nil && number < resp.Meta.LastPage {
+				checkpoint.Page, checkpoint.LastItemID = number+1, ""
+			}
+			return true
+		}
 
-			path, err := addOptions(pathURL, opts)
+		// fetch requests the page with the given number, it is safe for concurrent use
+		fetch := func(ctx context.Context, number int) ([]T, *Response, error) {
+			pageOpts := PO(new(O))
+			*pageOpts = *current
+			pageOpts.listOptions().Page = number
+
+			path, err := client.addListOptions(pathURL, pageOpts)
 			if err != nil {
-				iterErr = fmt.Errorf("failed to construct URL with options: %w", err)
-				return
+				return nil, nil, fmt.Errorf("failed to construct URL with options: %w", err)
 			}
 			req, err := client.NewRequest(http.MethodGet, path, nil)
 			if err != nil {
-				iterErr = fmt.Errorf("failed to prepare paginated request: %w", err)
-				return
+				return nil, nil, fmt.Errorf("failed to prepare paginated request: %w", err)
 			}
 
 			page := new(paginatedResponse[T])
 
 			resp, err := client.Do(ctx, req, page)
 			if err != nil {
-				iterErr = err
-				return
+				return nil, resp, err
 			}
 			if m := page.Meta; m != nil {
 				resp.Meta = m
 			}
+			return page.Data, resp, nil
+		}
 
-			for _, item := range page.Data {
-				if !yield(item, resp) {
-					// stop iteration if the consumer stops
-					return
-				}
+		for {
+			select {
+			// if the context has been canceled, the context's error is more useful
+			case <-ctx.Done():
+				iterErr = ctx.Err()
+				return
+			default:
+			}
+
+			items, resp, err := fetch(ctx, pagination.Page)
+			if err != nil {
+				iterErr = err
+				return
+			}
+
+			if !yieldPage(pagination.Page, items, resp) {
+				return
 			}
 
-			if resp.Meta == nil || opts.Page >= resp.Meta.LastPage {
+			if resp.Meta == nil || pagination.Page >= resp.Meta.LastPage {
 				// no more next pages, exit from pagination
 				break
 			}
 
-			opts.Page++
+			if config.prefetch > 1 {
+				// the number of pages is known now, fetch the remaining ones concurrently
+				iterErr = prefetchPages(ctx, config.prefetch, pagination.Page+1, resp.Meta.LastPage, fetch, yieldPage)
+				return
+			}
+
+			pagination.Page++
 		}
 	}
 
 	return seq, func() error { return iterErr }
 }
+
+// prefetchPages fetches the pages first to last with up to workers concurrent requests
+// and passes them to yieldPage in order. If yieldPage stops the iteration, requests in
+// flight are canceled and prefetchPages returns after all of them finished.
+func prefetchPages[T any](ctx context.Context, workers, first, last int, fetch func(ctx context.Context, number int) ([]T, *Response, error), yieldPage func(number int, items []T, resp *Response) bool) error {
+	type result struct {
+		number int
+		items  []T
+		resp   *Response
+		err    error
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	// pages are queued in order, each with its own channel receiving the result
+	pages := make(chan chan result, workers)
+	sem := make(chan struct{}, workers)
+	wg.Go(func() {
+		defer close(pages)
+		for number := first; number <= last; number++ {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			page := make(chan result, 1)
+			select {
+			case pages <- page:
+			case <-ctx.Done():
+				<-sem
+				return
+			}
+
+			wg.Go(func() {
+				defer func() { <-sem }()
+				items, resp, err := fetch(ctx, number)
+				page <- result{number: number, items: items, resp: resp, err: err}
+			})
+		}
+	})
+
+	for page := range pages {
+		result := <-page
+		if result.err != nil {
+			return result.err
+		}
+		if !yieldPage(result.number, result.items, result.resp) {
+			return nil
+		}
+	}
+	// the pages are incomplete if the context has been canceled
+	return ctx.Err()
+}
+
+// listFilters returns the query parameters of opts except for the pagination.
+func listFilters(opts any) (url.Values, error) {
+	filters, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+	filters.Del("page")
+	filters.Del("perPage")
+	return filters, nil
+}
+
+// itemID returns the ID field of item, or an empty string if it has none.
+func itemID(item any) string {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	if id := v.FieldByName("ID"); id.IsValid() && id.Kind() == reflect.String {
+		return id.String()
+	}
+	return ""
+}
+
+// emptyPaginator returns an iterator without items and an error function returning
+// err, e.g. for invalid arguments of an All method.
+func emptyPaginator[T any](err error) (iter.Seq2[T, *Response], func() error) {
+	return func(yield func(item T, resp *Response) bool) {}, func() error { return err }
+}
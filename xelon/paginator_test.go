@@ -0,0 +1,336 @@
+package xelon
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// collectIDs drains seq and returns the ids of all items.
+func collectIDs[T any](seq iter.Seq2[T, *Response], errFn func() error, id func(T) string) ([]string, error) {
+	var ids []string
+	for item := range seq {
+		ids = append(ids, id(item))
+	}
+	return ids, errFn()
+}
+
+func TestPaginator_All(t *testing.T) {
+	tests := map[string]struct {
+		path string
+		all  func() ([]string, error)
+	}{
+		"firewalls": {
+			path: "/firewalls",
+			all: func() ([]string, error) {
+				seq, errFn := client.Firewalls.All(ctx, &FirewallListOptions{ListOptions: ListOptions{PerPage: 1}})
+				return collectIDs(seq, errFn, func(v Firewall) string { return v.ID })
+			},
+		},
+		"isos": {
+			path: "/isos",
+			all: func() ([]string, error) {
+				seq, errFn := client.ISOs.All(ctx, &ISOListOptions{ListOptions: ListOptions{PerPage: 1}})
+				return collectIDs(seq, errFn, func(v ISO) string { return v.ID })
+			},
+		},
+		"load balancers": {
+			path: "/load-balancers",
+			all: func() ([]string, error) {
+				seq, errFn := client.LoadBalancers.All(ctx, &LoadBalancerListOptions{ListOptions: ListOptions{PerPage: 1}})
+				return collectIDs(seq, errFn, func(v LoadBalancer) string { return v.ID })
+			},
+		},
+		"persistent storages": {
+			path: "/persistent-storages",
+			all: func() ([]string, error) {
+				seq, errFn := client.PersistentStorages.All(ctx, &PersistentStorageListOptions{ListOptions: ListOptions{PerPage: 1}})
+				return collectIDs(seq, errFn, func(v PersistentStorage) string { return v.ID })
+			},
+		},
+		"ssh keys": {
+			path: "/ssh-keys",
+			all: func() ([]string, error) {
+				seq, errFn := client.SSHKeys.All(ctx, &SSHKeyListOptions{ListOptions: ListOptions{PerPage: 1}})
+				return collectIDs(seq, errFn, func(v SSHKey) string { return v.ID })
+			},
+		},
+		"tenants": {
+			path: "/tenants",
+			all: func() ([]string, error) {
+				seq, errFn := client.Tenants.All(ctx, &TenantListOptions{ListOptions: ListOptions{PerPage: 1}})
+				return collectIDs(seq, errFn, func(v Tenant) string { return v.ID })
+			},
+		},
+		"tenant users": {
+			path: "/tenants/tenant-1/users",
+			all: func() ([]string, error) {
+				seq, errFn := client.TenantUsers.All(ctx, "tenant-1", &TenantUserListOptions{ListOptions: ListOptions{PerPage: 1}})
+				return collectIDs(seq, errFn, func(v TenantUser) string { return v.ID })
+			},
+		},
+		"dns zones": {
+			path: "/dns",
+			all: func() ([]string, error) {
+				seq, errFn := client.Domains.AllZones(ctx, &DNSZoneListOptions{ListOptions: ListOptions{PerPage: 1}})
+				return collectIDs(seq, errFn, func(v DNSZone) string { return v.ID })
+			},
+		},
+		"snapshots": {
+			path: "/devices/dev-1/snapshots",
+			all: func() ([]string, error) {
+				seq, errFn := client.Snapshots.All(ctx, "dev-1", &SnapshotListOptions{ListOptions: ListOptions{PerPage: 1}})
+				return collectIDs(seq, errFn, func(v Snapshot) string { return v.ID })
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			mux.HandleFunc("GET "+test.path, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "1", r.URL.Query().Get("perPage"))
+				page := r.URL.Query().Get("page")
+				_, _ = fmt.Fprintf(w, `{"data":[{"identifier":"id-%v"}],"meta":{"currentPage":%[1]v,"lastPage":2,"perPage":1,"total":2}}`, page)
+			})
+
+			ids, err := test.all()
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"id-1", "id-2"}, ids)
+		})
+	}
+}
+
+func TestPaginator_AllEmptyArgument(t *testing.T) {
+	setup()
+	defer teardown()
+
+	seq, errFn := client.TenantUsers.All(ctx, "", nil)
+	ids, err := collectIDs(seq, errFn, func(v TenantUser) string { return v.ID })
+	assert.Empty(t, ids)
+	assert.ErrorIs(t, err, ErrEmptyArgument)
+
+	snapshots, errFn := client.Snapshots.All(ctx, "", nil)
+	ids, err = collectIDs(snapshots, errFn, func(v Snapshot) string { return v.ID })
+	assert.Empty(t, ids)
+	assert.EqualError(t, err, "failed to list snapshots: device id must be supplied")
+}
+
+func TestPaginator_AllWithFilters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("GET /devices", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "web", r.URL.Query().Get("search"))
+		assert.Equal(t, "hostName", r.URL.Query().Get("sort"))
+		page := r.URL.Query().Get("page")
+		_, _ = fmt.Fprintf(w, `{"data":[{"identifier":"dev-%v"}],"meta":{"currentPage":%[1]v,"lastPage":2}}`, page)
+	})
+	opts := &DeviceListOptions{Search: "web", Sort: DeviceSortHostName}
+
+	seq, errFn := client.Devices.All(ctx, opts)
+	ids, err := collectIDs(seq, errFn, func(v Device) string { return v.ID })
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dev-1", "dev-2"}, ids)
+	assert.Equal(t, &DeviceListOptions{Search: "web", Sort: DeviceSortHostName}, opts, "options of the caller must not be modified")
+
+	// the iterator can be ranged over again from the first page
+	ids, err = collectIDs(seq, errFn, func(v Device) string { return v.ID })
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dev-1", "dev-2"}, ids)
+}
+
+func TestPaginator_WithPrefetch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var inFlight, maxInFlight atomic.Int32
+	mux.HandleFunc("GET /devices", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page > 1 {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for current := maxInFlight.Load(); n > current && !maxInFlight.CompareAndSwap(current, n); current = maxInFlight.Load() {
+			}
+			// later pages respond faster, so they complete out of order
+			time.Sleep(time.Duration(8-page) * 3 * time.Millisecond)
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[{"identifier":"dev-%v-a"},{"identifier":"dev-%[1]v-b"}],"meta":{"currentPage":%[1]v,"lastPage":6}}`, page)
+	})
+
+	seq, errFn := client.Devices.All(ctx, nil, WithPrefetch(3))
+	ids, err := collectIDs(seq, errFn, func(v Device) string { return v.ID })
+
+	assert.NoError(t, err)
+	var expected []string
+	for page := 1; page <= 6; page++ {
+		expected = append(expected, fmt.Sprintf("dev-%v-a", page), fmt.Sprintf("dev-%v-b", page))
+	}
+	assert.Equal(t, expected, ids)
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(3))
+	assert.Greater(t, maxInFlight.Load(), int32(1))
+}
+
+func TestPaginator_WithPrefetchStopsEarly(t *testing.T) {
+	setup()
+	defer teardown()
+
+	canceled := make(chan int, 10)
+	mux.HandleFunc("GET /devices", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page > 2 {
+			select {
+			case <-r.Context().Done():
+				canceled <- page
+				return
+			case <-time.After(5 * time.Second):
+			}
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[{"identifier":"dev-%v"}],"meta":{"currentPage":%[1]v,"lastPage":10}}`, page)
+	})
+
+	seq, errFn := client.Devices.All(ctx, nil, WithPrefetch(4))
+	var ids []string
+	for device := range seq {
+		ids = append(ids, device.ID)
+		if device.ID == "dev-2" {
+			break
+		}
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"dev-1", "dev-2"}, ids)
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Error("requests in flight must be canceled")
+	}
+}
+
+func TestPaginator_WithCheckpoint(t *testing.T) {
+	setup()
+	defer teardown()
+
+	total := 6
+	mux.HandleFunc("GET /devices", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("perPage"))
+		assert.Equal(t, "web", r.URL.Query().Get("search"))
+		page := r.URL.Query().Get("page")
+		_, _ = fmt.Fprintf(w, `{"data":[{"identifier":"dev-%v-a"},{"identifier":"dev-%[1]v-b"}],"meta":{"currentPage":%[1]v,"lastPage":3,"total":%v}}`, page, total)
+	})
+	opts := &DeviceListOptions{Search: "web", ListOptions: ListOptions{PerPage: 2}}
+
+	checkpoint := new(Checkpoint)
+	seq, errFn := client.Devices.All(ctx, opts, WithCheckpoint(checkpoint))
+	for device := range seq {
+		assert.Equal(t, device.ID, checkpoint.LastItemID, "checkpoint must include the yielded item")
+		if device.ID == "dev-2-a" {
+			// simulate an interruption
+			break
+		}
+	}
+	assert.NoError(t, errFn())
+
+	data, err := json.Marshal(checkpoint)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"page":2,"perPage":2,"filters":{"search":["web"]},"lastItemId":"dev-2-a","total":6}`, string(data))
+
+	t.Run("resume", func(t *testing.T) {
+		resumed := new(Checkpoint)
+		assert.NoError(t, json.Unmarshal(data, resumed))
+
+		seq, errFn := client.Devices.All(ctx, &DeviceListOptions{Search: "web"}, WithCheckpoint(resumed))
+		ids, err := collectIDs(seq, errFn, func(v Device) string { return v.ID })
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"dev-2-b", "dev-3-a", "dev-3-b"}, ids)
+		assert.Equal(t, 3, resumed.Page)
+		assert.Equal(t, "dev-3-b", resumed.LastItemID)
+		assert.False(t, resumed.Shifted)
+	})
+
+	t.Run("resume after shift", func(t *testing.T) {
+		total = 7
+		defer func() { total = 6 }()
+		resumed := new(Checkpoint)
+		assert.NoError(t, json.Unmarshal(data, resumed))
+
+		seq, errFn := client.Devices.All(ctx, &DeviceListOptions{Search: "web"}, WithCheckpoint(resumed), WithPrefetch(2))
+		ids, err := collectIDs(seq, errFn, func(v Device) string { return v.ID })
+
+		assert.ErrorIs(t, err, ErrListShifted)
+		assert.Equal(t, []string{"dev-2-b", "dev-3-a", "dev-3-b"}, ids)
+		assert.True(t, resumed.Shifted)
+	})
+
+	t.Run("resume clears shift", func(t *testing.T) {
+		resumed := new(Checkpoint)
+		assert.NoError(t, json.Unmarshal(data, resumed))
+		resumed.Shifted = true
+
+		seq, errFn := client.Devices.All(ctx, &DeviceListOptions{Search: "web"}, WithCheckpoint(resumed))
+		_, err := collectIDs(seq, errFn, func(v Device) string { return v.ID })
+
+		assert.NoError(t, err)
+		assert.False(t, resumed.Shifted)
+	})
+
+	t.Run("resume with missing item", func(t *testing.T) {
+		resumed := &Checkpoint{Page: 3, PerPage: 2, Filters: url.Values{"search": {"web"}}, LastItemID: "dev-deleted", Total: 6}
+
+		seq, errFn := client.Devices.All(ctx, &DeviceListOptions{Search: "web"}, WithCheckpoint(resumed))
+		ids, err := collectIDs(seq, errFn, func(v Device) string { return v.ID })
+
+		assert.ErrorIs(t, err, ErrListShifted)
+		assert.Equal(t, []string{"dev-3-a", "dev-3-b"}, ids)
+		assert.True(t, resumed.Shifted)
+	})
+
+	t.Run("resume with other filters", func(t *testing.T) {
+		resumed := &Checkpoint{Page: 2, PerPage: 2, Filters: url.Values{"search": {"web"}}}
+
+		seq, errFn := client.Devices.All(ctx, &DeviceListOptions{Search: "db"}, WithCheckpoint(resumed))
+		ids, err := collectIDs(seq, errFn, func(v Device) string { return v.ID })
+
+		assert.Empty(t, ids)
+		assert.EqualError(t, err, "checkpoint filters search=web do not match the options search=db")
+	})
+}
+
+func TestPaginator_detectsShift(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("GET /devices", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		// an item was created while iterating
+		_, _ = fmt.Fprintf(w, `{"data":[{"identifier":"dev-%v"}],"meta":{"currentPage":%[1]v,"lastPage":2,"total":%v}}`, page, page+1)
+	})
+
+	checkpoint := new(Checkpoint)
+	seq, errFn := client.Devices.All(ctx, nil, WithCheckpoint(checkpoint))
+	ids, err := collectIDs(seq, errFn, func(v Device) string { return v.ID })
+
+	assert.ErrorIs(t, err, ErrListShifted)
+	assert.Equal(t, []string{"dev-1", "dev-2"}, ids)
+	assert.Equal(t, 2, checkpoint.Total)
+	assert.True(t, checkpoint.Shifted)
+
+	// shifts are detected without a checkpoint as well
+	seq, errFn = client.Devices.All(ctx, nil)
+	ids, err = collectIDs(seq, errFn, func(v Device) string { return v.ID })
+
+	assert.ErrorIs(t, err, ErrListShifted)
+	assert.Equal(t, []string{"dev-1", "dev-2"}, ids)
+}
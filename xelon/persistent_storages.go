@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -12,6 +13,20 @@ const persistentStorageBasePath = "persistent-storages"
 // PersistentStoragesService handles communication with the persistent storage related methods of the Xelon REST API.
 type PersistentStoragesService service
 
+// PersistentStoragesAPI is the interface implemented by PersistentStoragesService.
+type PersistentStoragesAPI interface {
+	List(ctx context.Context, opts *PersistentStorageListOptions) ([]PersistentStorage, *Response, error)
+	All(ctx context.Context, opts *PersistentStorageListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[PersistentStorage, *Response], func() error)
+	Get(ctx context.Context, persistentStorageID string) (*PersistentStorage, *Response, error)
+	Create(ctx context.Context, createRequest *PersistentStorageCreateRequest) (*PersistentStorage, *Response, error)
+	Delete(ctx context.Context, persistentStorageID string) (*Response, error)
+	AttachToDevice(ctx context.Context, persistentStorageID, deviceID string) (*Response, error)
+	DetachFromDevice(ctx context.Context, persistentStorageID, deviceID string) (*Response, error)
+	Extend(ctx context.Context, persistentStorageID string, capacity int) (*Response, error)
+}
+
+var _ PersistentStoragesAPI = (*PersistentStoragesService)(nil)
+
 type PersistentStorage struct {
 	AttachedDevices []PersistentStorageAttachedDevice `json:"attachedDevices,omitempty"`
 	Capacity        int                               `json:"capacity,omitempty"`
@@ -46,10 +61,31 @@ type persistentStorageExtendRequest struct {
 	Capacity int `json:"diskSize"`
 }
 
+// PersistentStorageSort is a field persistent storages can be sorted by, use SortDesc for descending order.
+type PersistentStorageSort string
+
+const (
+	PersistentStorageSortName     PersistentStorageSort = "name"
+	PersistentStorageSortType     PersistentStorageSort = "type"
+	PersistentStorageSortCapacity PersistentStorageSort = "capacity"
+)
+
+func (PersistentStorageSort) sortFields() []string {
+	return sortFieldNames(
+		PersistentStorageSortName,
+		PersistentStorageSortType,
+		PersistentStorageSortCapacity,
+	)
+}
+
 // PersistentStorageListOptions specifies the optional parameters to the PersistentStoragesService.List.
 type PersistentStorageListOptions struct {
-	Sort   string `url:"sort,omitempty"`
-	Search string `url:"search,omitempty"`
+	Sort   PersistentStorageSort `url:"sort,omitempty"`
+	Search string                `url:"search,omitempty"`
+
+	// CloudID and TenantID filter the list by cloud and tenant.
+	CloudID  string `url:"cloudIdentifier,omitempty"`
+	TenantID string `url:"tenantIdentifier,omitempty"`
 
 	ListOptions
 }
@@ -68,7 +104,7 @@ func (v PersistentStorage) String() string { return Stringify(v) }
 
 // List provides a list of all persistent storages.
 func (s *PersistentStoragesService) List(ctx context.Context, opts *PersistentStorageListOptions) ([]PersistentStorage, *Response, error) {
-	path, err := addOptions(persistentStorageBasePath, opts)
+	path, err := s.client.addListOptions(persistentStorageBasePath, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -79,7 +115,7 @@ func (s *PersistentStoragesService) List(ctx context.Context, opts *PersistentSt
 	}
 
 	root := new(persistentStoragesRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "PersistentStoragesService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -90,6 +126,13 @@ func (s *PersistentStoragesService) List(ctx context.Context, opts *PersistentSt
 	return root.PersistentStorages, resp, nil
 }
 
+// All returns an iterator to paginate over all persistent storages.
+//
+// The return iterator can be used in a for...range loop to easily process all persistent storages.
+func (s *PersistentStoragesService) All(ctx context.Context, opts *PersistentStorageListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[PersistentStorage, *Response], func() error) {
+	return newPaginator[PersistentStorage](withOperation(ctx, "PersistentStoragesService.All"), s.client, persistentStorageBasePath, opts, paginatorOpts...)
+}
+
 // Get provides detailed information for persistent storage identified by id.
 func (s *PersistentStoragesService) Get(ctx context.Context, persistentStorageID string) (*PersistentStorage, *Response, error) {
 	if persistentStorageID == "" {
@@ -103,7 +146,7 @@ func (s *PersistentStoragesService) Get(ctx context.Context, persistentStorageID
 	}
 
 	persistentStorage := new(PersistentStorage)
-	resp, err := s.client.Do(ctx, req, persistentStorage)
+	resp, err := s.client.Do(withOperation(ctx, "PersistentStoragesService.Get"), req, persistentStorage)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -123,7 +166,7 @@ func (s *PersistentStoragesService) Create(ctx context.Context, createRequest *P
 	}
 
 	root := new(persistentStorageRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "PersistentStoragesService.Create"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -143,7 +186,7 @@ func (s *PersistentStoragesService) Delete(ctx context.Context, persistentStorag
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "PersistentStoragesService.Delete"), req, nil)
 }
 
 // AttachToDevice connects persistent storage to specified device.
@@ -163,7 +206,7 @@ func (s *PersistentStoragesService) AttachToDevice(ctx context.Context, persiste
 	}
 
 	root := new(persistentStorageRoot)
-	return s.client.Do(ctx, req, root)
+	return s.client.Do(withOperation(ctx, "PersistentStoragesService.AttachToDevice"), req, root)
 }
 
 // DetachFromDevice disconnects persistent storage from specified device.
@@ -183,7 +226,7 @@ func (s *PersistentStoragesService) DetachFromDevice(ctx context.Context, persis
 	}
 
 	root := new(persistentStorageRoot)
-	return s.client.Do(ctx, req, root)
+	return s.client.Do(withOperation(ctx, "PersistentStoragesService.DetachFromDevice"), req, root)
 }
 
 // Extend increases persistent storage capacity.
@@ -200,5 +243,5 @@ func (s *PersistentStoragesService) Extend(ctx context.Context, persistentStorag
 	}
 
 	root := new(persistentStorageRoot)
-	return s.client.Do(ctx, req, root)
+	return s.client.Do(withOperation(ctx, "PersistentStoragesService.Extend"), req, root)
 }
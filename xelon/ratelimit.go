@@ -0,0 +1,132 @@
+package xelon
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// WithRateLimit configures Client to send at most requestsPerSecond requests on average,
+// allowing bursts of up to burst requests. Every attempt, including retries, consumes a token.
+// Waiting for a token respects cancellation of the request context.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(client *Client) {
+		if requestsPerSecond <= 0 {
+			client.rateLimiter = nil
+			return
+		}
+		client.rateLimiter = newTokenBucket(requestsPerSecond, burst)
+	}
+}
+
+// WithMaxInFlight configures Client to have at most n requests in flight at the same time.
+// A request is in flight until its response body is closed.
+func WithMaxInFlight(n int) ClientOption {
+	return func(client *Client) {
+		if n <= 0 {
+			client.inFlight = nil
+			return
+		}
+		client.inFlight = make(chan struct{}, n)
+	}
+}
+
+// tokenBucket is a token bucket rate limiter safe for concurrent use.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // maximum number of tokens
+	tokens float64 // available tokens, negative if tokens are reserved
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved but unused token to the bucket.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+// wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	d := b.reserve()
+	if d == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// acquire waits for the rate limiter and a free in-flight slot. The returned
+// function releases the slot and must be called exactly once.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if c.inFlight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case c.inFlight <- struct{}{}:
+		var once sync.Once
+		return func() { once.Do(func() { <-c.inFlight }) }, nil
+	}
+}
+
+// releaseOnClose releases an in-flight slot once the response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.release()
+	return err
+}
@@ -0,0 +1,85 @@
+package xelon
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit_tokenBucket_reserve(t *testing.T) {
+	now := time.Date(2025, 10, 27, 12, 0, 0, 0, time.UTC)
+	bucket := newTokenBucket(2, 2)
+	bucket.now = func() time.Time { return now }
+
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+	assert.Equal(t, 500*time.Millisecond, bucket.reserve())
+
+	now = now.Add(time.Second)
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+}
+
+func TestRateLimit_tokenBucket_waitRespectsContext(t *testing.T) {
+	bucket := newTokenBucket(0.001, 1)
+	assert.NoError(t, bucket.wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, bucket.wait(ctx), context.DeadlineExceeded)
+}
+
+func TestRateLimit_WithRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRateLimit(0.001, 1)(client)
+
+	mux.HandleFunc("GET /tenants/current", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"identifier":"tenant-1"}`))
+	})
+
+	_, _, err := client.Tenants.GetCurrent(ctx)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = client.Tenants.GetCurrent(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimit_WithMaxInFlight(t *testing.T) {
+	setup()
+	defer teardown()
+	WithMaxInFlight(2)(client)
+
+	var current, peak atomic.Int32
+	mux.HandleFunc("GET /tenants/current", func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"identifier":"tenant-1"}`))
+	})
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			_, _, err := client.Tenants.GetCurrent(ctx)
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+	assert.Empty(t, client.inFlight)
+}
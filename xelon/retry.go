@@ -0,0 +1,234 @@
+package xelon
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryMinBackoff  = 500 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy specifies how Client retries requests which failed with a transient error.
+//
+// By default, only idempotent requests (GET, HEAD, OPTIONS, PUT and DELETE) are retried.
+// Non-idempotent requests (POST and PATCH) are retried only if RetryNonIdempotent is set,
+// POST requests with an idempotency key also if RetryWithIdempotencyKey is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+
+	// MinBackoff is the base delay before the first retry. The delay grows
+	// exponentially with every attempt and is randomized with full jitter.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the upper bound for the delay between attempts. If the server asks
+	// for a longer delay with the Retry-After header, the request is not retried and
+	// the response is returned, see Response.Rate.
+	MaxBackoff time.Duration
+
+	// RetryableStatusCodes lists HTTP status codes which are considered transient.
+	RetryableStatusCodes []int
+
+	// RetryableError reports whether a transport error (e.g. dropped connection)
+	// is considered transient. If nil, IsRetryableNetworkError is used.
+	RetryableError func(err error) bool
+
+	// RetryNonIdempotent enables retries for POST and PATCH requests.
+	RetryNonIdempotent bool
+
+	// RetryWithIdempotencyKey enables retries for POST requests with an idempotency key.
+	// Only set it if the API honors the keys of the endpoints used.
+	RetryWithIdempotencyKey bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with 3 attempts, exponential backoff
+// between 500ms and 30s and retries for 429, 502, 503 and 504 status codes.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		MinBackoff:  defaultRetryMinBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// WithRetryPolicy configures Client to retry failed requests according to policy.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(client *Client) {
+		client.retryPolicy = &policy
+	}
+}
+
+// IsRetryableNetworkError reports whether err is a transient network error like
+// a timeout, a refused or reset connection or an unexpectedly closed connection.
+func IsRetryableNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	if netErr, ok := errors.AsType[net.Error](err); ok && netErr.Timeout() {
+		return true
+	}
+	return false
+}
+
+// isIdempotent reports whether the request method is idempotent as defined by RFC 9110.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryable reports whether req may be retried according to the policy.
+func (p *RetryPolicy) retryable(req *http.Request) bool {
+	if isIdempotent(req) || p.RetryNonIdempotent {
+		return true
+	}
+	return p.RetryWithIdempotencyKey && req.Header.Get(IdempotencyKeyHeader) != ""
+}
+
+// maxAttempts returns the number of attempts allowed for req.
+func (p *RetryPolicy) maxAttempts(req *http.Request) int {
+	if p == nil || p.MaxAttempts <= 1 {
+		return 1
+	}
+	if !p.retryable(req) {
+		return 1
+	}
+	// the body can only be sent again if it can be rewound
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// shouldRetry reports whether the outcome of an attempt is transient.
+func (p *RetryPolicy) shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		if p.RetryableError != nil {
+			return p.RetryableError(err)
+		}
+		return IsRetryableNetworkError(err)
+	}
+	return slices.Contains(p.RetryableStatusCodes, resp.StatusCode)
+}
+
+// backoff returns the delay before the next attempt. A valid Retry-After header
+// takes precedence over the computed exponential backoff. It returns false if the
+// server asks for a delay longer than MaxBackoff, so the request must not be retried.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultRetryMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return d, d <= maxBackoff
+		}
+	}
+
+	d := minBackoff
+	for i := 1; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, maxBackoff)
+
+	// full jitter spreads retries of concurrent clients over the whole interval
+	return time.Duration(rand.Int64N(int64(d) + 1)), true
+}
+
+// parseRetryAfter parses the Retry-After header value which is either
+// a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// send sends req and retries it according to the configured RetryPolicy.
+// The number of attempts is added to call.
+func (c *Client) send(ctx context.Context, call *Call, req *http.Request) (*http.Response, error) {
+	attempts := c.retryPolicy.maxAttempts(req)
+	if noRetry, _ := ctx.Value(noRetryContextKey{}).(bool); noRetry {
+		attempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		call.Attempts++
+		if attempt > 1 {
+			// replay the same body on every retry
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				req = req.Clone(ctx)
+				req.Body = body
+			}
+		}
+
+		resp, err := c.roundTrip(ctx, req)
+		if attempt >= attempts || !c.retryPolicy.shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		wait, ok := c.retryPolicy.backoff(attempt, resp)
+		if !ok {
+			return resp, err
+		}
+		if resp != nil {
+			// drain the body to allow reuse of the underlying connection
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package xelon
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 2 * time.Millisecond
+	return policy
+}
+
+func TestRetry_RetriesIdempotentRequest(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(testRetryPolicy())(client)
+
+	var calls int
+	mux.HandleFunc("GET /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"identifier":"key-1","name":"test"}]}`))
+	})
+
+	sshKeys, resp, err := client.SSHKeys.List(ctx, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []SSHKey{{ID: "key-1", Name: "test"}}, sshKeys)
+}
+
+func TestRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(testRetryPolicy())(client)
+
+	var calls int
+	mux.HandleFunc("GET /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, resp, err := client.SSHKeys.List(ctx, nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetry_SkipsNonIdempotentRequestByDefault(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(testRetryPolicy())(client)
+
+	var calls int
+	mux.HandleFunc("POST /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.SSHKeys.Create(ctx, &SSHKeyCreateRequest{SSHKey: SSHKey{Name: "test"}})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetry_ReplaysBodyForNonIdempotentRequest(t *testing.T) {
+	setup()
+	defer teardown()
+	policy := testRetryPolicy()
+	policy.RetryNonIdempotent = true
+	WithRetryPolicy(policy)(client)
+
+	var bodies []string
+	mux.HandleFunc("POST /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"identifier":"key-1","name":"test"}}`))
+	})
+
+	sshKey, _, err := client.SSHKeys.Create(ctx, &SSHKeyCreateRequest{SSHKey: SSHKey{Name: "test"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &SSHKey{ID: "key-1", Name: "test"}, sshKey)
+	if assert.Len(t, bodies, 2) {
+		assert.JSONEq(t, `{"name":"test","sshKey":""}`, bodies[0])
+		assert.Equal(t, bodies[0], bodies[1])
+	}
+}
+
+func TestRetry_StopsWhenContextIsCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+	policy := testRetryPolicy()
+	policy.MinBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	WithRetryPolicy(policy)(client)
+
+	mux.HandleFunc("GET /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := client.SSHKeys.List(ctx, nil)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRetry_parseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 10, 27, 12, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}
+	tests := map[string]testCase{
+		"empty":         {value: "", expected: 0, ok: false},
+		"seconds":       {value: "7", expected: 7 * time.Second, ok: true},
+		"negative":      {value: "-1", expected: 0, ok: false},
+		"http date":     {value: "Mon, 27 Oct 2025 12:00:30 GMT", expected: 30 * time.Second, ok: true},
+		"date in past":  {value: "Mon, 27 Oct 2025 11:00:00 GMT", expected: 0, ok: true},
+		"invalid value": {value: "soon", expected: 0, ok: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, ok := parseRetryAfter(test.value, now)
+
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRetry_backoffUsesRetryAfter(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+
+	wait, ok := policy.backoff(1, resp)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, wait)
+
+	wait, ok = policy.backoff(5, nil)
+	assert.True(t, ok)
+	assert.LessOrEqual(t, wait, policy.MaxBackoff)
+}
+
+func TestRetry_backoffRejectsRetryAfterAboveMaxBackoff(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+
+	_, ok := policy.backoff(1, resp)
+
+	assert.False(t, ok)
+}
+
+func TestRetry_GivesUpIfRetryAfterExceedsMaxBackoff(t *testing.T) {
+	setup()
+	defer teardown()
+	WithRetryPolicy(testRetryPolicy())(client)
+
+	var requests int
+	mux.HandleFunc("GET /tenants/current", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, resp, err := client.Tenants.GetCurrent(ctx)
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 1, requests, "request must not be retried before the requested delay")
+	assert.WithinDuration(t, time.Now().Add(time.Hour), resp.Rate.Reset, time.Minute)
+}
+
+func TestRetry_IsRetryableNetworkError(t *testing.T) {
+	assert.True(t, IsRetryableNetworkError(io.ErrUnexpectedEOF))
+	assert.True(t, IsRetryableNetworkError(syscall.ECONNRESET))
+	assert.False(t, IsRetryableNetworkError(context.Canceled))
+	assert.False(t, IsRetryableNetworkError(nil))
+}
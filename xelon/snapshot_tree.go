@@ -0,0 +1,157 @@
+package xelon
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// SnapshotTree is the hierarchy of the snapshots of a device, built from their ParentID.
+type SnapshotTree struct {
+	// Roots are the snapshots without parent in the tree, in the order of the list.
+	Roots []*SnapshotNode
+
+	nodes map[string]*SnapshotNode
+}
+
+// SnapshotNode is a snapshot in a SnapshotTree.
+type SnapshotNode struct {
+	Snapshot Snapshot
+	Parent   *SnapshotNode
+	Children []*SnapshotNode
+}
+
+// NewSnapshotTree returns the hierarchy of snapshots, e.g. the result of SnapshotsService.List.
+// Snapshots whose parent is not in snapshots become roots, so an incomplete list still
+// results in a valid tree.
+func NewSnapshotTree(snapshots []Snapshot) *SnapshotTree {
+	tree := &SnapshotTree{nodes: make(map[string]*SnapshotNode, len(snapshots))}
+	nodes := make([]*SnapshotNode, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		if _, ok := tree.nodes[snapshot.ID]; ok {
+			continue
+		}
+		node := &SnapshotNode{Snapshot: snapshot}
+		tree.nodes[snapshot.ID] = node
+		nodes = append(nodes, node)
+	}
+
+	for _, node := range nodes {
+		parent, ok := tree.nodes[node.Snapshot.ParentID]
+		if !ok || parent == node || parent.isDescendantOf(node) {
+			// unknown parents and cycles are not linked, the snapshot becomes a root
+			tree.Roots = append(tree.Roots, node)
+			continue
+		}
+		node.Parent = parent
+		parent.Children = append(parent.Children, node)
+	}
+
+	return tree
+}
+
+// isDescendantOf reports whether ancestor is on the path from n to its root.
+func (n *SnapshotNode) isDescendantOf(ancestor *SnapshotNode) bool {
+	for parent := n.Parent; parent != nil; parent = parent.Parent {
+		if parent == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of snapshots in the tree.
+func (t *SnapshotTree) Len() int {
+	return len(t.nodes)
+}
+
+// Find returns the node of the snapshot identified by id, or nil if it is not in the tree.
+func (t *SnapshotTree) Find(snapshotID string) *SnapshotNode {
+	return t.nodes[snapshotID]
+}
+
+// Current returns the node of the snapshot the device is currently based on, or nil if
+// no snapshot is marked as current.
+func (t *SnapshotTree) Current() *SnapshotNode {
+	for node := range t.Walk() {
+		if node.Snapshot.Current {
+			return node
+		}
+	}
+	return nil
+}
+
+// Walk returns an iterator over all nodes of the tree with their depth, roots have depth 0.
+// Parents are yielded before their children.
+func (t *SnapshotTree) Walk() iter.Seq2[*SnapshotNode, int] {
+	return func(yield func(node *SnapshotNode, depth int) bool) {
+		var walk func(nodes []*SnapshotNode, depth int) bool
+		walk = func(nodes []*SnapshotNode, depth int) bool {
+			for _, node := range nodes {
+				if !yield(node, depth) || !walk(node.Children, depth+1) {
+					return false
+				}
+			}
+			return true
+		}
+		walk(t.Roots, 0)
+	}
+}
+
+// Descendants returns the children of the node, their children and so on. Children are
+// returned before their parents, i.e. in the order they can be deleted one by one.
+func (n *SnapshotNode) Descendants() []Snapshot {
+	var descendants []Snapshot
+	for _, child := range n.Children {
+		descendants = append(descendants, child.Descendants()...)
+		descendants = append(descendants, child.Snapshot)
+	}
+	return descendants
+}
+
+// Removed returns the snapshots SnapshotsService.Delete with deleteRequest would remove
+// for the snapshot identified by id. Without RemoveChildSnapshots, only the snapshot
+// itself is removed and its children are attached to its parent. With it, all
+// descendants are removed as well, children before their parents.
+func (t *SnapshotTree) Removed(snapshotID string, deleteRequest *SnapshotDeleteRequest) ([]Snapshot, error) {
+	node := t.Find(snapshotID)
+	if node == nil {
+		return nil, fmt.Errorf("snapshot %v is not in the tree", snapshotID)
+	}
+
+	if deleteRequest == nil || !deleteRequest.RemoveChildSnapshots {
+		return []Snapshot{node.Snapshot}, nil
+	}
+	return append(node.Descendants(), node.Snapshot), nil
+}
+
+// String returns the tree with one snapshot per line, indented by depth. The current
+// snapshot is marked with "(current)".
+func (t *SnapshotTree) String() string {
+	var b strings.Builder
+	var write func(nodes []*SnapshotNode, prefix string)
+	write = func(nodes []*SnapshotNode, prefix string) {
+		for i, node := range nodes {
+			branch, indent := "├── ", "│   "
+			if i == len(nodes)-1 {
+				branch, indent = "└── ", "    "
+			}
+			b.WriteString(prefix + branch + node.label() + "\n")
+			write(node.Children, prefix+indent)
+		}
+	}
+	for _, root := range t.Roots {
+		b.WriteString(root.label() + "\n")
+		write(root.Children, "")
+	}
+	return b.String()
+}
+
+// label returns the name and id of the snapshot.
+func (n *SnapshotNode) label() string {
+	label := fmt.Sprintf("%v (%v)", n.Snapshot.Name, n.Snapshot.ID)
+	if n.Snapshot.Current {
+		label += " (current)"
+	}
+	return label
+}
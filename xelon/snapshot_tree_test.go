@@ -0,0 +1,141 @@
+package xelon
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSnapshots is the tree
+//
+//	base
+//	├── patch-1
+//	│   └── patch-2 (current)
+//	└── experiment
+//	    ├── experiment-1
+//	    └── experiment-2
+var testSnapshots = []Snapshot{
+	{ID: "snap-1", Name: "base"},
+	{ID: "snap-2", Name: "patch-1", ParentID: "snap-1"},
+	{ID: "snap-3", Name: "patch-2", ParentID: "snap-2", Current: true},
+	{ID: "snap-4", Name: "experiment", ParentID: "snap-1"},
+	{ID: "snap-5", Name: "experiment-1", ParentID: "snap-4"},
+	{ID: "snap-6", Name: "experiment-2", ParentID: "snap-4"},
+}
+
+func TestSnapshotTree(t *testing.T) {
+	tree := NewSnapshotTree(testSnapshots)
+
+	assert.Equal(t, 6, tree.Len())
+	require.Len(t, tree.Roots, 1)
+	assert.Equal(t, "snap-1", tree.Roots[0].Snapshot.ID)
+	assert.Equal(t, "snap-2", tree.Find("snap-3").Parent.Snapshot.ID)
+	assert.Nil(t, tree.Find("unknown"))
+	assert.Equal(t, "snap-3", tree.Current().Snapshot.ID)
+
+	var walked []string
+	for node, depth := range tree.Walk() {
+		walked = append(walked, fmt.Sprintf("%v:%d", node.Snapshot.ID, depth))
+	}
+	assert.Equal(t, []string{"snap-1:0", "snap-2:1", "snap-3:2", "snap-4:1", "snap-5:2", "snap-6:2"}, walked)
+
+	assert.Equal(t, `base (snap-1)
+├── patch-1 (snap-2)
+│   └── patch-2 (snap-3) (current)
+└── experiment (snap-4)
+    ├── experiment-1 (snap-5)
+    └── experiment-2 (snap-6)
+`, tree.String())
+}
+
+func TestSnapshotTree_Removed(t *testing.T) {
+	type test struct {
+		snapshotID    string
+		deleteRequest *SnapshotDeleteRequest
+		expectedIDs   []string
+		expectedError string
+	}
+	tests := map[string]test{
+		"without children": {
+			snapshotID:    "snap-4",
+			deleteRequest: &SnapshotDeleteRequest{RemoveChildSnapshots: false},
+			expectedIDs:   []string{"snap-4"},
+		},
+		"with children": {
+			snapshotID:    "snap-1",
+			deleteRequest: &SnapshotDeleteRequest{RemoveChildSnapshots: true},
+			expectedIDs:   []string{"snap-3", "snap-2", "snap-5", "snap-6", "snap-4", "snap-1"},
+		},
+		"leaf with children": {
+			snapshotID:    "snap-6",
+			deleteRequest: &SnapshotDeleteRequest{RemoveChildSnapshots: true},
+			expectedIDs:   []string{"snap-6"},
+		},
+		"unknown snapshot": {
+			snapshotID:    "unknown",
+			expectedError: "snapshot unknown is not in the tree",
+		},
+	}
+	tree := NewSnapshotTree(testSnapshots)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			removed, err := tree.Removed(tc.snapshotID, tc.deleteRequest)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			var ids []string
+			for _, snapshot := range removed {
+				ids = append(ids, snapshot.ID)
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestSnapshotTree_orphansAndCycles(t *testing.T) {
+	tree := NewSnapshotTree([]Snapshot{
+		{ID: "snap-1", ParentID: "deleted"},
+		{ID: "snap-2", ParentID: "snap-3"},
+		{ID: "snap-3", ParentID: "snap-2"},
+		{ID: "snap-4", ParentID: "snap-4"},
+	})
+
+	var roots []string
+	for _, root := range tree.Roots {
+		roots = append(roots, root.Snapshot.ID)
+	}
+	assert.Equal(t, []string{"snap-1", "snap-3", "snap-4"}, roots)
+	assert.Nil(t, tree.Current())
+
+	var walked int
+	for range tree.Walk() {
+		walked++
+	}
+	assert.Equal(t, 4, walked, "every snapshot must be reachable")
+}
+
+func TestSnapshotsService_Tree(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("GET /devices/dev-1/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "1" {
+			_, _ = fmt.Fprint(w, `{"data":[{"identifier":"snap-1"}],"meta":{"currentPage":1,"lastPage":2}}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"data":[{"identifier":"snap-2","parentIdentifier":"snap-1","isCurrent":true}],"meta":{"currentPage":2,"lastPage":2}}`)
+	})
+
+	tree, err := client.Snapshots.Tree(ctx, "dev-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, tree.Len())
+	assert.Equal(t, "snap-1", tree.Current().Parent.Snapshot.ID)
+}
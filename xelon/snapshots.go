@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -13,11 +15,56 @@ const snapshotBasePath = deviceBasePath + "/%v/snapshots"
 // SnapshotsService handles communication with the snapshots related methods of the Xelon REST API.
 type SnapshotsService service
 
+// SnapshotsAPI is the interface implemented by SnapshotsService.
+type SnapshotsAPI interface {
+	List(ctx context.Context, deviceID string, opts *SnapshotListOptions) ([]Snapshot, *Response, error)
+	All(ctx context.Context, deviceID string, opts *SnapshotListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Snapshot, *Response], func() error)
+	Get(ctx context.Context, deviceID, snapshotID string) (*Snapshot, *Response, error)
+	Create(ctx context.Context, deviceID string, createRequest *SnapshotCreateRequest) (*Snapshot, *Response, error)
+	Update(ctx context.Context, deviceID, snapshotID string, updateRequest *SnapshotUpdateRequest) (*Snapshot, *Response, error)
+	Delete(ctx context.Context, deviceID, snapshotID string, deleteRequest *SnapshotDeleteRequest) (*Response, error)
+	Revert(ctx context.Context, deviceID, snapshotID string) (*Response, error)
+	Tree(ctx context.Context, deviceID string) (*SnapshotTree, error)
+	WaitForStatus(ctx context.Context, deviceID, snapshotID string, status SnapshotStatus, opts *WaitOptions) (*Snapshot, error)
+	WaitForDeletion(ctx context.Context, deviceID, snapshotID string, opts *WaitOptions) error
+	WaitForRevert(ctx context.Context, deviceID, snapshotID string, opts *WaitOptions) (*Snapshot, error)
+}
+
+var _ SnapshotsAPI = (*SnapshotsService)(nil)
+
 type Snapshot struct {
-	Description string     `json:"description,omitempty"`
-	CreatedAt   *time.Time `json:"createdAt,omitempty"`
-	ID          string     `json:"identifier,omitempty"`
-	Name        string     `json:"name,omitempty"`
+	Description string         `json:"description,omitempty"`
+	CreatedAt   *time.Time     `json:"createdAt,omitempty"`
+	Current     bool           `json:"isCurrent,omitempty"`
+	ID          string         `json:"identifier,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	ParentID    string         `json:"parentIdentifier,omitempty"`
+	Status      SnapshotStatus `json:"status,omitempty"`
+}
+
+// SnapshotStatus is the status of a snapshot.
+type SnapshotStatus string
+
+const (
+	// SnapshotStatusCreating is the status of a snapshot which is being taken.
+	SnapshotStatusCreating SnapshotStatus = "creating"
+
+	// SnapshotStatusReady is the status of a snapshot which is complete.
+	SnapshotStatusReady SnapshotStatus = "ready"
+
+	// SnapshotStatusReverting is the status of a snapshot the device is reverted to.
+	SnapshotStatusReverting SnapshotStatus = "reverting"
+)
+
+type SnapshotCreateRequest struct {
+	Description   string `json:"description,omitempty"`
+	IncludeMemory bool   `json:"includeMemory"`
+	Name          string `json:"name"`
+}
+
+type SnapshotUpdateRequest struct {
+	Description string `json:"description,omitempty"`
+	Name        string `json:"name"`
 }
 
 type SnapshotDeleteRequest struct {
@@ -28,6 +75,11 @@ type SnapshotListOptions struct {
 	ListOptions
 }
 
+type snapshotRoot struct {
+	Snapshot *Snapshot `json:"data,omitempty"`
+	Message  string    `json:"message,omitempty"`
+}
+
 type snapshotsRoot struct {
 	Snapshots []Snapshot `json:"data"`
 	Meta      *Meta      `json:"meta,omitempty"`
@@ -52,7 +104,7 @@ func (s *SnapshotsService) List(ctx context.Context, deviceID string, opts *Snap
 	}
 
 	root := new(snapshotsRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "SnapshotsService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -63,6 +115,92 @@ func (s *SnapshotsService) List(ctx context.Context, deviceID string, opts *Snap
 	return root.Snapshots, resp, err
 }
 
+// All returns an iterator to paginate over all snapshots of the device identified by id.
+//
+// The return iterator can be used in a for...range loop to easily process all snapshots.
+func (s *SnapshotsService) All(ctx context.Context, deviceID string, opts *SnapshotListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Snapshot, *Response], func() error) {
+	if deviceID == "" {
+		return emptyPaginator[Snapshot](errors.New("failed to list snapshots: device id must be supplied"))
+	}
+
+	return newPaginator[Snapshot](withOperation(ctx, "SnapshotsService.All"), s.client, fmt.Sprintf(snapshotBasePath, deviceID), opts, paginatorOpts...)
+}
+
+// Get provides detailed information for snapshot identified by id.
+func (s *SnapshotsService) Get(ctx context.Context, deviceID, snapshotID string) (*Snapshot, *Response, error) {
+	if deviceID == "" {
+		return nil, nil, errors.New("failed to get snapshot: device id must be supplied")
+	}
+	if snapshotID == "" {
+		return nil, nil, errors.New("failed to get snapshot: id must be supplied")
+	}
+
+	path := fmt.Sprintf(snapshotBasePath+"/%v", deviceID, snapshotID)
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshotRoot := new(snapshotRoot)
+	resp, err := s.client.Do(withOperation(ctx, "SnapshotsService.Get"), req, snapshotRoot)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return snapshotRoot.Snapshot, resp, nil
+}
+
+// Create takes a snapshot of the device identified by id. The snapshot is taken
+// asynchronously, use WaitForStatus with SnapshotStatusReady to wait until it is complete.
+func (s *SnapshotsService) Create(ctx context.Context, deviceID string, createRequest *SnapshotCreateRequest) (*Snapshot, *Response, error) {
+	if deviceID == "" {
+		return nil, nil, errors.New("failed to create snapshot: device id must be supplied")
+	}
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create snapshot: payload must be supplied")
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf(snapshotBasePath, deviceID), createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshotRoot := new(snapshotRoot)
+	resp, err := s.client.Do(withOperation(ctx, "SnapshotsService.Create"), req, snapshotRoot)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return snapshotRoot.Snapshot, resp, nil
+}
+
+// Update changes name and description of snapshot identified by id.
+func (s *SnapshotsService) Update(ctx context.Context, deviceID, snapshotID string, updateRequest *SnapshotUpdateRequest) (*Snapshot, *Response, error) {
+	if deviceID == "" {
+		return nil, nil, errors.New("failed to update snapshot: device id must be supplied")
+	}
+	if snapshotID == "" {
+		return nil, nil, errors.New("failed to update snapshot: id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update snapshot: payload must be supplied")
+	}
+
+	path := fmt.Sprintf(snapshotBasePath+"/%v", deviceID, snapshotID)
+	req, err := s.client.NewRequest(http.MethodPatch, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshotRoot := new(snapshotRoot)
+	resp, err := s.client.Do(withOperation(ctx, "SnapshotsService.Update"), req, snapshotRoot)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return snapshotRoot.Snapshot, resp, nil
+}
+
 // Delete removes snapshot identified by id.
 func (s *SnapshotsService) Delete(ctx context.Context, deviceID, snapshotID string, deleteRequest *SnapshotDeleteRequest) (*Response, error) {
 	if deviceID == "" {
@@ -81,5 +219,112 @@ func (s *SnapshotsService) Delete(ctx context.Context, deviceID, snapshotID stri
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "SnapshotsService.Delete"), req, nil)
+}
+
+// Tree fetches all snapshots of the device identified by id and returns their hierarchy.
+func (s *SnapshotsService) Tree(ctx context.Context, deviceID string) (*SnapshotTree, error) {
+	if deviceID == "" {
+		return nil, errors.New("failed to get snapshot tree: device id must be supplied")
+	}
+
+	var snapshots []Snapshot
+	seq, errFn := s.All(ctx, deviceID, nil)
+	for snapshot := range seq {
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := errFn(); err != nil {
+		return nil, err
+	}
+
+	return NewSnapshotTree(snapshots), nil
+}
+
+// Revert restores the device to the state of snapshot identified by id. The device is
+// reverted asynchronously, use WaitForRevert to wait until it is complete.
+func (s *SnapshotsService) Revert(ctx context.Context, deviceID, snapshotID string) (*Response, error) {
+	if deviceID == "" {
+		return nil, errors.New("failed to revert snapshot: device id must be supplied")
+	}
+	if snapshotID == "" {
+		return nil, errors.New("failed to revert snapshot: id must be supplied")
+	}
+
+	path := fmt.Sprintf(snapshotBasePath+"/%v/revert", deviceID, snapshotID)
+	req, err := s.client.NewRequest(http.MethodPost, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(withOperation(ctx, "SnapshotsService.Revert"), req, nil)
+}
+
+// WaitForStatus waits until the snapshot identified by id reaches status (compared
+// case-insensitively), e.g. SnapshotStatusReady after calling Create. It returns the
+// last fetched snapshot.
+func (s *SnapshotsService) WaitForStatus(ctx context.Context, deviceID, snapshotID string, status SnapshotStatus, opts *WaitOptions) (*Snapshot, error) {
+	if deviceID == "" {
+		return nil, errors.New("failed to wait for snapshot status: device id must be supplied")
+	}
+	if snapshotID == "" {
+		return nil, errors.New("failed to wait for snapshot status: id must be supplied")
+	}
+
+	return Wait(ctx,
+		func(ctx context.Context) (*Snapshot, error) {
+			snapshot, _, err := s.Get(ctx, deviceID, snapshotID)
+			return snapshot, err
+		},
+		func(snapshot *Snapshot) (bool, error) {
+			return snapshot != nil && strings.EqualFold(string(snapshot.Status), string(status)), nil
+		},
+		opts,
+	)
+}
+
+// WaitForDeletion waits until the snapshot identified by id is gone, e.g. after calling
+// Delete.
+func (s *SnapshotsService) WaitForDeletion(ctx context.Context, deviceID, snapshotID string, opts *WaitOptions) error {
+	if deviceID == "" {
+		return errors.New("failed to wait for snapshot deletion: device id must be supplied")
+	}
+	if snapshotID == "" {
+		return errors.New("failed to wait for snapshot deletion: id must be supplied")
+	}
+
+	_, err := Wait(ctx,
+		func(ctx context.Context) (bool, error) {
+			_, _, err := s.Get(ctx, deviceID, snapshotID)
+			if IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		},
+		func(deleted bool) (bool, error) { return deleted, nil },
+		opts,
+	)
+	return err
+}
+
+// WaitForRevert waits until the device identified by id is reverted to the snapshot
+// identified by id after calling Revert, i.e. the snapshot is the current one of the
+// device and is no longer reverting. It returns the last fetched snapshot.
+func (s *SnapshotsService) WaitForRevert(ctx context.Context, deviceID, snapshotID string, opts *WaitOptions) (*Snapshot, error) {
+	if deviceID == "" {
+		return nil, errors.New("failed to wait for snapshot revert: device id must be supplied")
+	}
+	if snapshotID == "" {
+		return nil, errors.New("failed to wait for snapshot revert: id must be supplied")
+	}
+
+	return Wait(ctx,
+		func(ctx context.Context) (*Snapshot, error) {
+			snapshot, _, err := s.Get(ctx, deviceID, snapshotID)
+			return snapshot, err
+		},
+		func(snapshot *Snapshot) (bool, error) {
+			return snapshot != nil && snapshot.Current && strings.EqualFold(string(snapshot.Status), string(SnapshotStatusReady)), nil
+		},
+		opts,
+	)
 }
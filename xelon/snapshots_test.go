@@ -0,0 +1,147 @@
+package xelon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotsService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("POST /devices/dev-1/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"name": "pre-change", "description": "before patching", "includeMemory": true}, body)
+		_, _ = fmt.Fprint(w, `{"data":{"identifier":"snap-1","name":"pre-change","status":"creating"},"message":"Snapshot is being created."}`)
+	})
+
+	snapshot, _, err := client.Snapshots.Create(ctx, "dev-1", &SnapshotCreateRequest{
+		Name:          "pre-change",
+		Description:   "before patching",
+		IncludeMemory: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Snapshot{ID: "snap-1", Name: "pre-change", Status: SnapshotStatusCreating}, snapshot)
+}
+
+func TestSnapshotsService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("PATCH /devices/dev-1/snapshots/snap-1", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"name": "renamed"}, body)
+		_, _ = fmt.Fprint(w, `{"data":{"identifier":"snap-1","name":"renamed","status":"ready"}}`)
+	})
+
+	snapshot, _, err := client.Snapshots.Update(ctx, "dev-1", "snap-1", &SnapshotUpdateRequest{Name: "renamed"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Snapshot{ID: "snap-1", Name: "renamed", Status: SnapshotStatusReady}, snapshot)
+}
+
+func TestSnapshotsService_Revert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	reverted := false
+	mux.HandleFunc("POST /devices/dev-1/snapshots/snap-1/revert", func(w http.ResponseWriter, r *http.Request) {
+		reverted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Snapshots.Revert(ctx, "dev-1", "snap-1")
+
+	assert.NoError(t, err)
+	assert.True(t, reverted)
+}
+
+func TestSnapshotsService_emptyArguments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := client.Snapshots.Get(ctx, "", "snap-1")
+	assert.EqualError(t, err, "failed to get snapshot: device id must be supplied")
+
+	_, _, err = client.Snapshots.Create(ctx, "dev-1", nil)
+	assert.EqualError(t, err, "failed to create snapshot: payload must be supplied")
+
+	_, err = client.Snapshots.Revert(ctx, "dev-1", "")
+	assert.EqualError(t, err, "failed to revert snapshot: id must be supplied")
+}
+
+func TestSnapshotsService_WaitForStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var polls atomic.Int32
+	mux.HandleFunc("GET /devices/dev-1/snapshots/snap-1", func(w http.ResponseWriter, r *http.Request) {
+		status := "creating"
+		if polls.Add(1) == 3 {
+			status = "Ready"
+		}
+		_, _ = fmt.Fprintf(w, `{"data":{"identifier":"snap-1","status":%q}}`, status)
+	})
+
+	snapshot, err := client.Snapshots.WaitForStatus(ctx, "dev-1", "snap-1", SnapshotStatusReady, &WaitOptions{Interval: time.Millisecond})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Snapshot{ID: "snap-1", Status: "Ready"}, snapshot)
+	assert.Equal(t, int32(3), polls.Load())
+}
+
+func TestSnapshotsService_WaitForDeletion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var polls atomic.Int32
+	mux.HandleFunc("GET /devices/dev-1/snapshots/snap-1", func(w http.ResponseWriter, r *http.Request) {
+		if polls.Add(1) == 2 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"error":"snapshot not found"}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"data":{"identifier":"snap-1","status":"deleting"}}`)
+	})
+
+	err := client.Snapshots.WaitForDeletion(ctx, "dev-1", "snap-1", &WaitOptions{Interval: time.Millisecond})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), polls.Load())
+}
+
+func TestSnapshotsService_WaitForRevert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var polls atomic.Int32
+	mux.HandleFunc("GET /devices/dev-1/snapshots/snap-1", func(w http.ResponseWriter, r *http.Request) {
+		switch polls.Add(1) {
+		case 1:
+			_, _ = fmt.Fprint(w, `{"data":{"identifier":"snap-1","status":"ready"}}`)
+		case 2:
+			_, _ = fmt.Fprint(w, `{"data":{"identifier":"snap-1","status":"reverting","isCurrent":true}}`)
+		default:
+			_, _ = fmt.Fprint(w, `{"data":{"identifier":"snap-1","status":"ready","isCurrent":true}}`)
+		}
+	})
+
+	snapshot, err := client.Snapshots.WaitForRevert(ctx, "dev-1", "snap-1", &WaitOptions{Interval: time.Millisecond})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Snapshot{ID: "snap-1", Status: SnapshotStatusReady, Current: true}, snapshot)
+	assert.Equal(t, int32(3), polls.Load())
+
+	_, err = client.Snapshots.WaitForRevert(ctx, "dev-1", "", nil)
+	assert.EqualError(t, err, "failed to wait for snapshot revert: id must be supplied")
+}
@@ -0,0 +1,80 @@
+package xelon
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// sortDescendingPrefix is the prefix of a sort field for descending order.
+const sortDescendingPrefix = "-"
+
+// SortAsc returns field for sorting in ascending order, e.g.
+// SortAsc(DeviceSortHostName).
+func SortAsc[S ~string](field S) S {
+	return S(strings.TrimPrefix(string(field), sortDescendingPrefix))
+}
+
+// SortDesc returns field for sorting in descending order, e.g.
+// SortDesc(DeviceSortHostName).
+func SortDesc[S ~string](field S) S {
+	return sortDescendingPrefix + SortAsc(field)
+}
+
+// WithSortValidation configures whether Client rejects sort fields unknown to the SDK
+// before a list request is sent, so a typo like "-createdat" is reported instead of
+// being ignored by the API. The known fields are the sort constants like
+// DeviceSortHostName. It is enabled by default, disable it to send a sort field the
+// SDK does not know yet.
+func WithSortValidation(enabled bool) ClientOption {
+	return func(client *Client) {
+		client.sortValidation = enabled
+	}
+}
+
+// sortField is implemented by the sort types of list options, e.g. DeviceSort.
+type sortField interface {
+	sortFields() []string
+}
+
+// sortFieldNames returns the names of the sort fields.
+func sortFieldNames[S ~string](fields ...S) []string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, string(field))
+	}
+	return names
+}
+
+// addListOptions adds opts as URL query parameters to s like addOptions. Unknown sort
+// fields of opts are rejected unless disabled with WithSortValidation.
+func (c *Client) addListOptions(s string, opts any) (string, error) {
+	if c.sortValidation {
+		if err := validateSort(opts); err != nil {
+			return s, err
+		}
+	}
+	return addOptions(s, opts)
+}
+
+// validateSort checks all sort fields of opts against the fields known to the SDK.
+func validateSort(opts any) error {
+	v := reflect.Indirect(reflect.ValueOf(opts))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := range v.NumField() {
+		field := v.Field(i)
+		sorter, ok := field.Interface().(sortField)
+		if !ok || field.String() == "" {
+			continue
+		}
+		name := strings.TrimPrefix(field.String(), sortDescendingPrefix)
+		if known := sorter.sortFields(); !slices.Contains(known, name) {
+			return fmt.Errorf("unknown sort field %q, known fields are %s", name, strings.Join(known, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package xelon
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortAscDesc(t *testing.T) {
+	assert.Equal(t, DeviceSort("hostName"), SortAsc(DeviceSortHostName))
+	assert.Equal(t, DeviceSort("-hostName"), SortDesc(DeviceSortHostName))
+	assert.Equal(t, DeviceSort("-hostName"), SortDesc(SortDesc(DeviceSortHostName)))
+	assert.Equal(t, DeviceSort("hostName"), SortAsc(SortDesc(DeviceSortHostName)))
+}
+
+func TestClient_addListOptions(t *testing.T) {
+	type test struct {
+		opts              any
+		disableValidation bool
+		expectedPath      string
+		expectedError     string
+	}
+	state := 0
+	tests := map[string]test{
+		"ascending": {
+			opts:         &FirewallListOptions{Sort: FirewallSortCreatedAt},
+			expectedPath: "firewalls?sort=createdAt",
+		},
+		"descending": {
+			opts:         &FirewallListOptions{Sort: SortDesc(FirewallSortCreatedAt)},
+			expectedPath: "firewalls?sort=-createdAt",
+		},
+		"unknown field": {
+			opts:          &FirewallListOptions{Sort: "-createdat"},
+			expectedError: `unknown sort field "createdat", known fields are name, state, createdAt`,
+		},
+		"unknown field without validation": {
+			opts:              &FirewallListOptions{Sort: "-createdat"},
+			disableValidation: true,
+			expectedPath:      "firewalls?sort=-createdat",
+		},
+		"filters": {
+			opts:         &FirewallListOptions{CloudID: "cloud-1", TenantID: "tenant-1", State: &state},
+			expectedPath: "firewalls?cloudIdentifier=cloud-1&state=0&tenantIdentifier=tenant-1",
+		},
+		"options without sort": {
+			opts:         &CloudListOptions{TenantID: "tenant-1"},
+			expectedPath: "firewalls?tenantIdentifier=tenant-1",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := NewClient("token", WithSortValidation(!tc.disableValidation))
+
+			path, err := client.addListOptions("firewalls", tc.opts)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPath, path)
+			}
+		})
+	}
+}
+
+func TestTenantUsersService_List_unknownSortField(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requested := false
+	mux.HandleFunc("GET /tenants/{id}/users", func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	})
+
+	_, _, err := client.TenantUsers.List(ctx, "tenant-1", &TenantUserListOptions{Sort: "mail"})
+
+	assert.ErrorContains(t, err, `unknown sort field "mail"`)
+	assert.False(t, requested, "request with unknown sort field must not be sent")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -13,6 +14,19 @@ const sshBasePath = "ssh-keys"
 // SSHKeysService handles communication with the SSH keys related methods of the Xelon REST API.
 type SSHKeysService service
 
+// SSHKeysAPI is the interface implemented by SSHKeysService.
+type SSHKeysAPI interface {
+	List(ctx context.Context, opts *SSHKeyListOptions) ([]SSHKey, *Response, error)
+	All(ctx context.Context, opts *SSHKeyListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[SSHKey, *Response], func() error)
+	Get(ctx context.Context, sshKeyID string) (*SSHKey, *Response, error)
+	Create(ctx context.Context, createRequest *SSHKeyCreateRequest) (*SSHKey, *Response, error)
+	Update(ctx context.Context, sshKeyID string, updateRequest *SSHKeyUpdateRequest) (*SSHKey, *Response, error)
+	Delete(ctx context.Context, sshKeyID string) (*Response, error)
+	DeleteMany(ctx context.Context, sshKeyIDs []string, concurrency int) BulkResults[string]
+}
+
+var _ SSHKeysAPI = (*SSHKeysService)(nil)
+
 // SSHKey represents a Xelon SSH key.
 type SSHKey struct {
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
@@ -29,10 +43,25 @@ type SSHKeyUpdateRequest struct {
 	SSHKey
 }
 
+// SSHKeySort is a field SSH keys can be sorted by, use SortDesc for descending order.
+type SSHKeySort string
+
+const (
+	SSHKeySortName      SSHKeySort = "name"
+	SSHKeySortCreatedAt SSHKeySort = "createdAt"
+)
+
+func (SSHKeySort) sortFields() []string {
+	return sortFieldNames(
+		SSHKeySortName,
+		SSHKeySortCreatedAt,
+	)
+}
+
 // SSHKeyListOptions specifies the optional parameters to the SSHKeysService.List.
 type SSHKeyListOptions struct {
-	Sort   string `url:"sort,omitempty"`
-	Search string `url:"search,omitempty"`
+	Sort   SSHKeySort `url:"sort,omitempty"`
+	Search string     `url:"search,omitempty"`
 
 	ListOptions
 }
@@ -51,7 +80,7 @@ func (v SSHKey) String() string { return Stringify(v) }
 
 // List provides a list of all SSH keys.
 func (s *SSHKeysService) List(ctx context.Context, opts *SSHKeyListOptions) ([]SSHKey, *Response, error) {
-	path, err := addOptions(sshBasePath, opts)
+	path, err := s.client.addListOptions(sshBasePath, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -62,7 +91,7 @@ func (s *SSHKeysService) List(ctx context.Context, opts *SSHKeyListOptions) ([]S
 	}
 
 	root := new(sshKeysRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "SSHKeysService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -73,6 +102,13 @@ func (s *SSHKeysService) List(ctx context.Context, opts *SSHKeyListOptions) ([]S
 	return root.SSHKeys, resp, nil
 }
 
+// All returns an iterator to paginate over all SSH keys.
+//
+// The return iterator can be used in a for...range loop to easily process all SSH keys.
+func (s *SSHKeysService) All(ctx context.Context, opts *SSHKeyListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[SSHKey, *Response], func() error) {
+	return newPaginator[SSHKey](withOperation(ctx, "SSHKeysService.All"), s.client, sshBasePath, opts, paginatorOpts...)
+}
+
 // Get provides detailed information for SSH key identified by id.
 func (s *SSHKeysService) Get(ctx context.Context, sshKeyID string) (*SSHKey, *Response, error) {
 	if sshKeyID == "" {
@@ -86,7 +122,7 @@ func (s *SSHKeysService) Get(ctx context.Context, sshKeyID string) (*SSHKey, *Re
 	}
 
 	sshKey := new(SSHKey)
-	resp, err := s.client.Do(ctx, req, sshKey)
+	resp, err := s.client.Do(withOperation(ctx, "SSHKeysService.Get"), req, sshKey)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -106,7 +142,7 @@ func (s *SSHKeysService) Create(ctx context.Context, createRequest *SSHKeyCreate
 	}
 
 	sshKeyRoot := new(sshKeyRoot)
-	resp, err := s.client.Do(ctx, req, sshKeyRoot)
+	resp, err := s.client.Do(withOperation(ctx, "SSHKeysService.Create"), req, sshKeyRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -130,7 +166,7 @@ func (s *SSHKeysService) Update(ctx context.Context, sshKeyID string, updateRequ
 	}
 
 	sshKeyRoot := new(sshKeyRoot)
-	resp, err := s.client.Do(ctx, req, sshKeyRoot)
+	resp, err := s.client.Do(withOperation(ctx, "SSHKeysService.Update"), req, sshKeyRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -150,5 +186,14 @@ func (s *SSHKeysService) Delete(ctx context.Context, sshKeyID string) (*Response
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "SSHKeysService.Delete"), req, nil)
+}
+
+// DeleteMany removes the SSH keys identified by ids with at most concurrency requests
+// in flight, see Bulk.
+func (s *SSHKeysService) DeleteMany(ctx context.Context, sshKeyIDs []string, concurrency int) BulkResults[string] {
+	return Bulk(ctx, sshKeyIDs, concurrency, func(ctx context.Context, sshKeyID string) error {
+		_, err := s.Delete(ctx, sshKeyID)
+		return err
+	})
 }
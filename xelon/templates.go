@@ -14,6 +14,19 @@ const templatesBasePath = "templates"
 // TemplatesService handles communication with the template related methods of the Xelon API.
 type TemplatesService service
 
+// TemplatesAPI is the interface implemented by TemplatesService.
+type TemplatesAPI interface {
+	List(ctx context.Context, opts *TemplateListOptions) ([]Template, *Response, error)
+	All(ctx context.Context, opts *TemplateListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Template, *Response], func() error)
+	Get(ctx context.Context, templateID string) (*Template, *Response, error)
+	Create(ctx context.Context, createRequest *TemplateCreateRequest) (*Template, *Response, error)
+	Update(ctx context.Context, templateID string, updateRequest *TemplateUpdateRequest) (*Template, *Response, error)
+	Delete(ctx context.Context, templateID string) (*Response, error)
+	WaitForStatus(ctx context.Context, templateID string, status int, opts *WaitOptions) (*Template, error)
+}
+
+var _ TemplatesAPI = (*TemplatesService)(nil)
+
 // Template represents a Xelon base image.
 type Template struct {
 	Description   string     `json:"description,omitempty"`
@@ -42,11 +55,33 @@ type TemplateUpdateRequest struct {
 	Name        string `json:"name"`
 }
 
+// TemplateSort is a field templates can be sorted by, use SortDesc for descending order.
+type TemplateSort string
+
+const (
+	TemplateSortName      TemplateSort = "name"
+	TemplateSortType      TemplateSort = "type"
+	TemplateSortCreatedAt TemplateSort = "createdAt"
+	TemplateSortUpdatedAt TemplateSort = "updatedAt"
+)
+
+func (TemplateSort) sortFields() []string {
+	return sortFieldNames(
+		TemplateSortName,
+		TemplateSortType,
+		TemplateSortCreatedAt,
+		TemplateSortUpdatedAt,
+	)
+}
+
 // TemplateListOptions specifies the optional parameters to the TemplatesService.List.
 type TemplateListOptions struct {
-	Sort   string `url:"sort,omitempty"`
-	Search string `url:"search,omitempty"`
-	Type   string `url:"type,omitempty"`
+	Sort   TemplateSort `url:"sort,omitempty"`
+	Search string       `url:"search,omitempty"`
+	Type   string       `url:"type,omitempty"`
+
+	// CloudID filters the list by cloud.
+	CloudID string `url:"cloudIdentifier,omitempty"`
 
 	ListOptions
 }
@@ -65,7 +100,7 @@ func (v Template) String() string { return Stringify(v) }
 
 // List provides a list of available templates.
 func (s *TemplatesService) List(ctx context.Context, opts *TemplateListOptions) ([]Template, *Response, error) {
-	path, err := addOptions(templatesBasePath, opts)
+	path, err := s.client.addListOptions(templatesBasePath, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -76,7 +111,7 @@ func (s *TemplatesService) List(ctx context.Context, opts *TemplateListOptions)
 	}
 
 	root := new(templatesRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "TemplatesService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -90,8 +125,8 @@ func (s *TemplatesService) List(ctx context.Context, opts *TemplateListOptions)
 // All returns an iterator to paginate over all available templates.
 //
 // The return iterator can be used in a for...range loop to easily process all templates.
-func (s *TemplatesService) All(ctx context.Context, opts *ListOptions) (iter.Seq2[Template, *Response], func() error) {
-	return newPaginator[Template](ctx, s.client, templatesBasePath, opts)
+func (s *TemplatesService) All(ctx context.Context, opts *TemplateListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Template, *Response], func() error) {
+	return newPaginator[Template](withOperation(ctx, "TemplatesService.All"), s.client, templatesBasePath, opts, paginatorOpts...)
 }
 
 // Get provides detailed information for template identified by id.
@@ -107,7 +142,7 @@ func (s *TemplatesService) Get(ctx context.Context, templateID string) (*Templat
 	}
 
 	template := new(Template)
-	resp, err := s.client.Do(ctx, req, template)
+	resp, err := s.client.Do(withOperation(ctx, "TemplatesService.Get"), req, template)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -128,7 +163,7 @@ func (s *TemplatesService) Create(ctx context.Context, createRequest *TemplateCr
 	}
 
 	templateRoot := new(templateRoot)
-	resp, err := s.client.Do(ctx, req, templateRoot)
+	resp, err := s.client.Do(withOperation(ctx, "TemplatesService.Create"), req, templateRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -152,7 +187,7 @@ func (s *TemplatesService) Update(ctx context.Context, templateID string, update
 	}
 
 	templateRoot := new(templateRoot)
-	resp, err := s.client.Do(ctx, req, templateRoot)
+	resp, err := s.client.Do(withOperation(ctx, "TemplatesService.Update"), req, templateRoot)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -172,5 +207,24 @@ func (s *TemplatesService) Delete(ctx context.Context, templateID string) (*Resp
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "TemplatesService.Delete"), req, nil)
+}
+
+// WaitForStatus waits until the template identified by id reaches status, e.g. after
+// creating it from a device with Create. It returns the last fetched template.
+func (s *TemplatesService) WaitForStatus(ctx context.Context, templateID string, status int, opts *WaitOptions) (*Template, error) {
+	if templateID == "" {
+		return nil, errors.New("failed to wait for template status: id must be supplied")
+	}
+
+	return Wait(ctx,
+		func(ctx context.Context) (*Template, error) {
+			template, _, err := s.Get(ctx, templateID)
+			return template, err
+		},
+		func(template *Template) (bool, error) {
+			return template.Status == status, nil
+		},
+		opts,
+	)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -12,6 +13,22 @@ const tenantUsersBasePath = "tenants/%s/users"
 // TenantUsersService handles communication with tenant user methods of the Xelon API.
 type TenantUsersService service
 
+// TenantUsersAPI is the interface implemented by TenantUsersService.
+type TenantUsersAPI interface {
+	List(ctx context.Context, tenantID string, opts *TenantUserListOptions) ([]TenantUser, *Response, error)
+	All(ctx context.Context, tenantID string, opts *TenantUserListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[TenantUser, *Response], func() error)
+	Get(ctx context.Context, tenantID, userID string) (*TenantUserWithDetails, *Response, error)
+	Create(ctx context.Context, tenantID string, createRequest *TenantUserCreateRequest) (*TenantUser, *Response, error)
+	Update(ctx context.Context, tenantID, userID string, updateRequest *TenantUserUpdateRequest) (*TenantUser, *Response, error)
+	Delete(ctx context.Context, tenantID, userID string) (*Response, error)
+	Restore(ctx context.Context, tenantID, userID string) (*Response, error)
+	UpdatePassword(ctx context.Context, tenantID, userID string, updateRequest *TenantUserPasswordUpdateRequest) (*Response, error)
+	ListAvailablePermissions(ctx context.Context, tenantID string) ([]TenantUserPermission, *Response, error)
+	UpdatePermissions(ctx context.Context, tenantID, userID string, updateRequest *TenantUserPermissionsUpdateRequest) (*Response, error)
+}
+
+var _ TenantUsersAPI = (*TenantUsersService)(nil)
+
 // TenantUser represents a user that belongs to a Xelon tenant.
 type TenantUser struct {
 	BusinessPhone string `json:"business_phone,omitempty"`
@@ -68,10 +85,27 @@ type TenantUserPasswordUpdateRequest struct {
 	PasswordConfirmation string `json:"password_confirmation"`
 }
 
+// TenantUserSort is a field tenant users can be sorted by, use SortDesc for descending order.
+type TenantUserSort string
+
+const (
+	TenantUserSortName    TenantUserSort = "name"
+	TenantUserSortSurname TenantUserSort = "surname"
+	TenantUserSortEmail   TenantUserSort = "email"
+)
+
+func (TenantUserSort) sortFields() []string {
+	return sortFieldNames(
+		TenantUserSortName,
+		TenantUserSortSurname,
+		TenantUserSortEmail,
+	)
+}
+
 // TenantUserListOptions specifies the optional parameters to the TenantUsersService.List.
 type TenantUserListOptions struct {
-	Search string `url:"search,omitempty"`
-	Sort   string `url:"sort,omitempty"`
+	Search string         `url:"search,omitempty"`
+	Sort   TenantUserSort `url:"sort,omitempty"`
 
 	ListOptions
 }
@@ -101,7 +135,7 @@ func (s *TenantUsersService) List(ctx context.Context, tenantID string, opts *Te
 		return nil, nil, fmt.Errorf("tenant id: %w", ErrEmptyArgument)
 	}
 
-	path, err := addOptions(fmt.Sprintf(tenantUsersBasePath, tenantID), opts)
+	path, err := s.client.addListOptions(fmt.Sprintf(tenantUsersBasePath, tenantID), opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -112,7 +146,7 @@ func (s *TenantUsersService) List(ctx context.Context, tenantID string, opts *Te
 	}
 
 	root := new(tenantUsersRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "TenantUsersService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -123,6 +157,17 @@ func (s *TenantUsersService) List(ctx context.Context, tenantID string, opts *Te
 	return root.TenantUsers, resp, nil
 }
 
+// All returns an iterator to paginate over all users of the tenant identified by id.
+//
+// The return iterator can be used in a for...range loop to easily process all users.
+func (s *TenantUsersService) All(ctx context.Context, tenantID string, opts *TenantUserListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[TenantUser, *Response], func() error) {
+	if tenantID == "" {
+		return emptyPaginator[TenantUser](fmt.Errorf("tenant id: %w", ErrEmptyArgument))
+	}
+
+	return newPaginator[TenantUser](withOperation(ctx, "TenantUsersService.All"), s.client, fmt.Sprintf(tenantUsersBasePath, tenantID), opts, paginatorOpts...)
+}
+
 // Get gets a tenant user by id, including detailed roles, permissions, and active state.
 func (s *TenantUsersService) Get(ctx context.Context, tenantID, userID string) (*TenantUserWithDetails, *Response, error) {
 	if tenantID == "" {
@@ -139,7 +184,7 @@ func (s *TenantUsersService) Get(ctx context.Context, tenantID, userID string) (
 	}
 
 	root := new(tenantUserWithDetailsRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "TenantUsersService.Get"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -165,7 +210,7 @@ func (s *TenantUsersService) Create(ctx context.Context, tenantID string, create
 	}
 
 	root := new(tenantUserRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "TenantUsersService.Create"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -195,7 +240,7 @@ func (s *TenantUsersService) Update(ctx context.Context, tenantID, userID string
 	}
 
 	root := new(tenantUserRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "TenantUsersService.Update"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -221,7 +266,7 @@ func (s *TenantUsersService) Delete(ctx context.Context, tenantID, userID string
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "TenantUsersService.Delete"), req, nil)
 }
 
 // Restore restores a previously deleted tenant user.
@@ -244,7 +289,7 @@ func (s *TenantUsersService) Restore(ctx context.Context, tenantID, userID strin
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "TenantUsersService.Restore"), req, nil)
 }
 
 // UpdatePassword updates a tenant user's password.
@@ -265,7 +310,7 @@ func (s *TenantUsersService) UpdatePassword(ctx context.Context, tenantID, userI
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "TenantUsersService.UpdatePassword"), req, nil)
 }
 
 type TenantUserPermission struct {
@@ -296,7 +341,7 @@ func (s *TenantUsersService) ListAvailablePermissions(ctx context.Context, tenan
 	}
 
 	var permissions []TenantUserPermission
-	resp, err := s.client.Do(ctx, req, &permissions)
+	resp, err := s.client.Do(withOperation(ctx, "TenantUsersService.ListAvailablePermissions"), req, &permissions)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -322,5 +367,5 @@ func (s *TenantUsersService) UpdatePermissions(ctx context.Context, tenantID, us
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.client.Do(withOperation(ctx, "TenantUsersService.UpdatePermissions"), req, nil)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -12,6 +13,16 @@ const tenantBasePath = "tenants"
 // TenantsService handles communication with the user related methods of the Xelon REST API.
 type TenantsService service
 
+// TenantsAPI is the interface implemented by TenantsService.
+type TenantsAPI interface {
+	GetCurrent(ctx context.Context) (*Tenant, *Response, error)
+	List(ctx context.Context, opts *TenantListOptions) ([]Tenant, *Response, error)
+	All(ctx context.Context, opts *TenantListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Tenant, *Response], func() error)
+	Get(ctx context.Context, tenantID string) (*Tenant, *Response, error)
+}
+
+var _ TenantsAPI = (*TenantsService)(nil)
+
 // Tenant represents a top-level entity in the Xelon cloud.
 type Tenant struct {
 	ID     string `json:"identifier,omitempty"`
@@ -21,10 +32,27 @@ type Tenant struct {
 	Type   string `json:"type,omitempty"`
 }
 
+// TenantSort is a field tenants can be sorted by, use SortDesc for descending order.
+type TenantSort string
+
+const (
+	TenantSortName   TenantSort = "name"
+	TenantSortType   TenantSort = "type"
+	TenantSortStatus TenantSort = "status"
+)
+
+func (TenantSort) sortFields() []string {
+	return sortFieldNames(
+		TenantSortName,
+		TenantSortType,
+		TenantSortStatus,
+	)
+}
+
 // TenantListOptions specifies the optional parameters to the TenantsService.List.
 type TenantListOptions struct {
-	Sort   string `url:"sort,omitempty"`
-	Search string `url:"search,omitempty"`
+	Sort   TenantSort `url:"sort,omitempty"`
+	Search string     `url:"search,omitempty"`
 
 	ListOptions
 }
@@ -45,7 +73,7 @@ func (s *TenantsService) GetCurrent(ctx context.Context) (*Tenant, *Response, er
 	}
 
 	tenant := new(Tenant)
-	resp, err := s.client.Do(ctx, req, tenant)
+	resp, err := s.client.Do(withOperation(ctx, "TenantsService.GetCurrent"), req, tenant)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -55,7 +83,7 @@ func (s *TenantsService) GetCurrent(ctx context.Context) (*Tenant, *Response, er
 
 // List providers a list of all tenants.
 func (s *TenantsService) List(ctx context.Context, opts *TenantListOptions) ([]Tenant, *Response, error) {
-	path, err := addOptions(tenantBasePath, opts)
+	path, err := s.client.addListOptions(tenantBasePath, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -66,7 +94,7 @@ func (s *TenantsService) List(ctx context.Context, opts *TenantListOptions) ([]T
 	}
 
 	root := new(tenantsRoot)
-	resp, err := s.client.Do(ctx, req, root)
+	resp, err := s.client.Do(withOperation(ctx, "TenantsService.List"), req, root)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -77,6 +105,13 @@ func (s *TenantsService) List(ctx context.Context, opts *TenantListOptions) ([]T
 	return root.Tenants, resp, nil
 }
 
+// All returns an iterator to paginate over all tenants.
+//
+// The return iterator can be used in a for...range loop to easily process all tenants.
+func (s *TenantsService) All(ctx context.Context, opts *TenantListOptions, paginatorOpts ...PaginatorOption) (iter.Seq2[Tenant, *Response], func() error) {
+	return newPaginator[Tenant](withOperation(ctx, "TenantsService.All"), s.client, tenantBasePath, opts, paginatorOpts...)
+}
+
 // Get provides detailed information for tenant identified by id.
 func (s *TenantsService) Get(ctx context.Context, tenantID string) (*Tenant, *Response, error) {
 	if tenantID == "" {
@@ -90,7 +125,7 @@ func (s *TenantsService) Get(ctx context.Context, tenantID string) (*Tenant, *Re
 	}
 
 	tenant := new(Tenant)
-	resp, err := s.client.Do(ctx, req, tenant)
+	resp, err := s.client.Do(withOperation(ctx, "TenantsService.Get"), req, tenant)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -0,0 +1,218 @@
+package xelon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrEmptyToken is returned by a TokenSource which has no token to provide.
+var ErrEmptyToken = errors.New("token cannot be empty")
+
+// TokenSource provides bearer tokens to authenticate API requests. Client calls Token
+// for every request, so implementations are responsible for caching if needed.
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// TokenRefresher is implemented by token sources which can discard their current token.
+// If the API rejects a token with 401 Unauthorized, Client calls Refresh and retries
+// the request once with a new token.
+type TokenRefresher interface {
+	Refresh() error
+}
+
+// WithTokenSource configures Client to obtain the bearer token from tokenSource for every request.
+// It takes precedence over the token passed to NewClient.
+func WithTokenSource(tokenSource TokenSource) ClientOption {
+	return func(client *Client) {
+		client.tokenSource = tokenSource
+	}
+}
+
+type staticTokenSource string
+
+// StaticTokenSource returns a TokenSource which always provides token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+func (s staticTokenSource) Token() (string, error) {
+	return string(s), nil
+}
+
+type envTokenSource string
+
+// EnvTokenSource returns a TokenSource which reads the token from the environment
+// variable name on every call, e.g. "XELON_TOKEN".
+func EnvTokenSource(name string) TokenSource {
+	return envTokenSource(name)
+}
+
+func (s envTokenSource) Token() (string, error) {
+	token := strings.TrimSpace(os.Getenv(string(s)))
+	if token == "" {
+		return "", fmt.Errorf("environment variable %v: %w", string(s), ErrEmptyToken)
+	}
+	return token, nil
+}
+
+// Refresh does nothing, the environment variable is read again on the next call anyway.
+// It allows Client to retry a rejected request once the variable has been changed.
+func (s envTokenSource) Refresh() error {
+	return nil
+}
+
+// fileTokenSource reads the token from a file and re-reads it once the file changes.
+type fileTokenSource struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// FileTokenSource returns a TokenSource which reads the token from the file at path.
+// The file is re-read whenever its modification time or size changes, so the token
+// can be rotated (e.g. by a mounted Kubernetes secret) without recreating the client.
+func FileTokenSource(path string) TokenSource {
+	return &fileTokenSource{path: path}
+}
+
+func (s *fileTokenSource) Token() (string, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("file %v: %w", s.path, ErrEmptyToken)
+	}
+
+	s.token, s.modTime, s.size = token, info.ModTime(), info.Size()
+	return s.token, nil
+}
+
+// Refresh discards the cached token, so the file is read again on the next call.
+func (s *fileTokenSource) Refresh() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = ""
+	return nil
+}
+
+// cachingTokenSource caches the token of another TokenSource for a fixed duration.
+type cachingTokenSource struct {
+	source TokenSource
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// CachingTokenSource returns a TokenSource which caches tokens of source for ttl.
+// It is useful for sources which are expensive to query, e.g. a secret manager.
+func CachingTokenSource(source TokenSource, ttl time.Duration) TokenSource {
+	return &cachingTokenSource{source: source, ttl: ttl, now: time.Now}
+}
+
+func (s *cachingTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	token, err := s.source.Token()
+	if err != nil {
+		return "", err
+	}
+	s.token, s.expiresAt = token, s.now().Add(s.ttl)
+	return s.token, nil
+}
+
+// Refresh discards the cached token and refreshes the underlying source if supported.
+func (s *cachingTokenSource) Refresh() error {
+	s.mu.Lock()
+	s.token = ""
+	s.mu.Unlock()
+
+	if refresher, ok := s.source.(TokenRefresher); ok {
+		return refresher.Refresh()
+	}
+	return nil
+}
+
+// authorize sets the Authorization header of req with a token from the token source.
+func (c *Client) authorize(req *http.Request) error {
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		return fmt.Errorf("failed to get token: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return nil
+}
+
+// reauthorize refreshes the token source after resp rejected req with 401 Unauthorized
+// and returns a copy of req with a new token. It returns false if the request cannot
+// be retried, e.g. because the token source cannot be refreshed or the token did not change.
+func (c *Client) reauthorize(ctx context.Context, req *http.Request, resp *http.Response) (*http.Request, bool) {
+	if resp.StatusCode != http.StatusUnauthorized {
+		return nil, false
+	}
+	refresher, ok := c.tokenSource.(TokenRefresher)
+	if !ok {
+		return nil, false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return nil, false
+	}
+	if err := refresher.Refresh(); err != nil {
+		return nil, false
+	}
+
+	retryReq := req.Clone(ctx)
+	if err := c.authorize(retryReq); err != nil {
+		return nil, false
+	}
+	if retryReq.Header.Get("Authorization") == req.Header.Get("Authorization") {
+		return nil, false
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, false
+		}
+		retryReq.Body = body
+	}
+
+	// drain the body to allow reuse of the underlying connection
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	return retryReq, true
+}
@@ -0,0 +1,197 @@
+package xelon
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rotatingTokenSource struct {
+	tokens    []string
+	current   atomic.Int32
+	refreshes atomic.Int32
+}
+
+func (s *rotatingTokenSource) Token() (string, error) {
+	return s.tokens[s.current.Load()], nil
+}
+
+func (s *rotatingTokenSource) Refresh() error {
+	s.refreshes.Add(1)
+	if int(s.current.Load()) < len(s.tokens)-1 {
+		s.current.Add(1)
+	}
+	return nil
+}
+
+func TestToken_WithTokenSource(t *testing.T) {
+	client := NewClient("auth-token", WithTokenSource(StaticTokenSource("rotated-token")))
+
+	req, err := client.NewRequest(http.MethodGet, "devices", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer rotated-token", req.Header.Get("Authorization"))
+}
+
+func TestToken_NewRequest_tokenSourceError(t *testing.T) {
+	t.Setenv("XELON_TESTING_TOKEN", "")
+	client := NewClient("", WithTokenSource(EnvTokenSource("XELON_TESTING_TOKEN")))
+
+	_, err := client.NewRequest(http.MethodGet, "devices", nil)
+
+	assert.ErrorIs(t, err, ErrEmptyToken)
+}
+
+func TestToken_refreshesOnUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+	tokenSource := &rotatingTokenSource{tokens: []string{"expired-token", "fresh-token"}}
+	WithTokenSource(tokenSource)(client)
+
+	var authorizations []string
+	mux.HandleFunc("POST /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer fresh-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"identifier":"key-1","name":"test"}}`))
+	})
+
+	sshKey, _, err := client.SSHKeys.Create(ctx, &SSHKeyCreateRequest{SSHKey: SSHKey{Name: "test"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", sshKey.ID)
+	assert.Equal(t, []string{"Bearer expired-token", "Bearer fresh-token"}, authorizations)
+	assert.Equal(t, int32(1), tokenSource.refreshes.Load())
+}
+
+func TestToken_refreshesOnlyOnce(t *testing.T) {
+	setup()
+	defer teardown()
+	tokenSource := &rotatingTokenSource{tokens: []string{"expired-token", "invalid-token", "fresh-token"}}
+	WithTokenSource(tokenSource)(client)
+
+	var requests int
+	mux.HandleFunc("GET /tenants/current", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, _, err := client.Tenants.GetCurrent(ctx)
+
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, 2, requests)
+}
+
+func TestToken_staticTokenIsNotRetried(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("GET /tenants/current", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, _, err := client.Tenants.GetCurrent(ctx)
+
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, 1, requests)
+}
+
+func TestToken_EnvTokenSource(t *testing.T) {
+	t.Setenv("XELON_TESTING_TOKEN", " env-token\n")
+
+	token, err := EnvTokenSource("XELON_TESTING_TOKEN").Token()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "env-token", token)
+}
+
+func TestToken_EnvTokenSource_refreshesOnUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+	t.Setenv("XELON_TESTING_TOKEN", "expired-token")
+	WithTokenSource(EnvTokenSource("XELON_TESTING_TOKEN"))(client)
+
+	var authorizations []string
+	mux.HandleFunc("GET /tenants/current", func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer fresh-token" {
+			// the token is rotated while the request is rejected
+			_ = os.Setenv("XELON_TESTING_TOKEN", "fresh-token")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"identifier":"tenant-1"}}`))
+	})
+
+	_, _, err := client.Tenants.GetCurrent(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Bearer expired-token", "Bearer fresh-token"}, authorizations)
+}
+
+func TestToken_FileTokenSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first-token\n"), 0o600))
+	tokenSource := FileTokenSource(path)
+
+	token, err := tokenSource.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "first-token", token)
+
+	require.NoError(t, os.WriteFile(path, []byte("second-token-rotated\n"), 0o600))
+	token, err = tokenSource.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "second-token-rotated", token)
+
+	require.NoError(t, os.WriteFile(path, []byte(""), 0o600))
+	_, err = tokenSource.Token()
+	assert.ErrorIs(t, err, ErrEmptyToken)
+
+	_, err = FileTokenSource(filepath.Join(t.TempDir(), "missing")).Token()
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+type countingTokenSource struct {
+	calls int
+	err   error
+}
+
+func (s *countingTokenSource) Token() (string, error) {
+	s.calls++
+	return "token", s.err
+}
+
+func TestToken_CachingTokenSource(t *testing.T) {
+	now := time.Date(2025, 10, 27, 12, 0, 0, 0, time.UTC)
+	source := &countingTokenSource{}
+	tokenSource := CachingTokenSource(source, time.Minute).(*cachingTokenSource)
+	tokenSource.now = func() time.Time { return now }
+
+	_, _ = tokenSource.Token()
+	_, _ = tokenSource.Token()
+	assert.Equal(t, 1, source.calls)
+
+	now = now.Add(2 * time.Minute)
+	_, _ = tokenSource.Token()
+	assert.Equal(t, 2, source.calls)
+
+	assert.NoError(t, tokenSource.Refresh())
+	_, _ = tokenSource.Token()
+	assert.Equal(t, 3, source.calls)
+
+	source.err = errors.New("secret manager unavailable")
+	assert.NoError(t, tokenSource.Refresh())
+	_, err := tokenSource.Token()
+	assert.EqualError(t, err, "secret manager unavailable")
+}
@@ -0,0 +1,159 @@
+package xelon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultWaitInterval    = 5 * time.Second
+	defaultWaitMaxInterval = time.Minute
+	defaultWaitMultiplier  = 1.5
+	defaultWaitTimeout     = 30 * time.Minute
+)
+
+// errWaitTimeout is the cause of the context canceled by the wait timeout.
+var errWaitTimeout = errors.New("wait timeout")
+
+// WaitOptions specifies the optional parameters to Wait and the service waiters like
+// DevicesService.WaitForPowerState.
+type WaitOptions struct {
+	// Interval is the delay before the first poll is repeated, 5s by default.
+	Interval time.Duration
+
+	// MaxInterval is the upper bound of the delay between polls, 1m by default.
+	MaxInterval time.Duration
+
+	// Multiplier increases the delay after every poll, 1.5 by default.
+	// Use 1 for a constant interval.
+	Multiplier float64
+
+	// Timeout limits the total wait time, 30m by default. Use a negative value
+	// to wait until the context is canceled.
+	Timeout time.Duration
+
+	// RetryableError reports whether a fetch error is transient, e.g. a not yet
+	// visible resource. By default, every fetch error stops waiting.
+	RetryableError func(err error) bool
+
+	// OnProgress is called after every poll.
+	OnProgress func(progress WaitProgress)
+}
+
+// WaitProgress describes the outcome of a single poll.
+type WaitProgress struct {
+	Attempt int           // Attempt is the number of the poll, starting at 1.
+	Elapsed time.Duration // Elapsed is the time since waiting started.
+	Value   any           // Value is the fetched value, the zero value if fetching failed.
+	Err     error         // Err is the fetch error, if any.
+}
+
+// WaitTimeoutError is returned by Wait if the condition is not met within WaitOptions.Timeout.
+// It matches context.DeadlineExceeded with errors.Is.
+type WaitTimeoutError struct {
+	Attempts int           // Attempts is the number of polls made.
+	Elapsed  time.Duration // Elapsed is the total wait time.
+	LastErr  error         // LastErr is the last retryable fetch error, if any.
+}
+
+func (e *WaitTimeoutError) Error() string {
+	message := fmt.Sprintf("timed out after %v waiting for condition (%d attempts)", e.Elapsed.Round(time.Millisecond), e.Attempts)
+	if e.LastErr != nil {
+		message += fmt.Sprintf(": last error: %v", e.LastErr)
+	}
+	return message
+}
+
+// Unwrap returns context.DeadlineExceeded.
+func (e *WaitTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// Wait polls fetch until done reports true, done or fetch returns an error, the timeout
+// elapses or ctx is canceled. The delay between polls grows exponentially. It returns the
+// last fetched value.
+//
+// Most mutating calls like DevicesService.Start return before the work is done, so Wait
+// can be used to block until the resource reaches the desired state:
+//
+//	device, err := xelon.Wait(ctx,
+//		func(ctx context.Context) (*xelon.Device, error) {
+//			device, _, err := client.Devices.Get(ctx, deviceID)
+//			return device, err
+//		},
+//		func(device *xelon.Device) (bool, error) { return device.PoweredOn, nil },
+//		nil,
+//	)
+func Wait[T any](ctx context.Context, fetch func(ctx context.Context) (T, error), done func(v T) (bool, error), opts *WaitOptions) (T, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultWaitMaxInterval
+	}
+	multiplier := opts.Multiplier
+	if multiplier < 1 {
+		multiplier = defaultWaitMultiplier
+	}
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = defaultWaitTimeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout, errWaitTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	var last T
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		v, err := fetch(ctx)
+		if opts.OnProgress != nil {
+			opts.OnProgress(WaitProgress{Attempt: attempt, Elapsed: time.Since(start), Value: v, Err: err})
+		}
+
+		if err == nil {
+			// a value fetched before the context expired is still checked
+			last = v
+			ok, err := done(v)
+			if err != nil || ok {
+				return v, err
+			}
+		}
+
+		switch {
+		case ctx.Err() != nil:
+			return last, waitError(ctx, attempt, start, lastErr)
+		case err != nil && (opts.RetryableError == nil || !opts.RetryableError(err)):
+			return v, err
+		case err != nil:
+			lastErr = err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, waitError(ctx, attempt, start, lastErr)
+		case <-timer.C:
+		}
+		interval = min(time.Duration(float64(interval)*multiplier), maxInterval)
+	}
+}
+
+// waitError returns a WaitTimeoutError if the wait timeout elapsed, or the context error otherwise.
+func waitError(ctx context.Context, attempts int, start time.Time, lastErr error) error {
+	if errors.Is(context.Cause(ctx), errWaitTimeout) {
+		return &WaitTimeoutError{Attempts: attempts, Elapsed: time.Since(start), LastErr: lastErr}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,191 @@
+package xelon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWait(t *testing.T) {
+	var polls int
+	var progress []WaitProgress
+	opts := &WaitOptions{
+		Interval:   time.Millisecond,
+		OnProgress: func(p WaitProgress) { progress = append(progress, p) },
+	}
+
+	v, err := Wait(ctx,
+		func(ctx context.Context) (int, error) {
+			polls++
+			return polls, nil
+		},
+		func(v int) (bool, error) { return v == 3, nil },
+		opts,
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+	require.Len(t, progress, 3)
+	assert.Equal(t, 3, progress[2].Attempt)
+	assert.Equal(t, 3, progress[2].Value)
+}
+
+func TestWait_doneError(t *testing.T) {
+	errFailed := errors.New("provisioning failed")
+
+	_, err := Wait(ctx,
+		func(ctx context.Context) (string, error) { return "failed", nil },
+		func(v string) (bool, error) { return false, errFailed },
+		&WaitOptions{Interval: time.Millisecond},
+	)
+
+	assert.ErrorIs(t, err, errFailed)
+}
+
+func TestWait_fetchError(t *testing.T) {
+	errTransient := errors.New("not found yet")
+	var polls int
+
+	tests := map[string]struct {
+		retryable     func(error) bool
+		expectedPolls int
+		expectedErr   error
+	}{
+		"non-retryable": {
+			expectedPolls: 1,
+			expectedErr:   errTransient,
+		},
+		"retryable": {
+			retryable:     func(err error) bool { return errors.Is(err, errTransient) },
+			expectedPolls: 3,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			polls = 0
+			_, err := Wait(ctx,
+				func(ctx context.Context) (int, error) {
+					polls++
+					if polls < 3 {
+						return 0, errTransient
+					}
+					return polls, nil
+				},
+				func(v int) (bool, error) { return true, nil },
+				&WaitOptions{Interval: time.Millisecond, RetryableError: test.retryable},
+			)
+
+			assert.Equal(t, test.expectedPolls, polls)
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWait_timeout(t *testing.T) {
+	v, err := Wait(ctx,
+		func(ctx context.Context) (string, error) { return "provisioning", nil },
+		func(v string) (bool, error) { return false, nil },
+		&WaitOptions{Interval: time.Millisecond, Multiplier: 1, Timeout: 20 * time.Millisecond},
+	)
+
+	var timeoutErr *WaitTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Greater(t, timeoutErr.Attempts, 1)
+	assert.GreaterOrEqual(t, timeoutErr.Elapsed, 20*time.Millisecond)
+	assert.Equal(t, "provisioning", v)
+}
+
+func TestWait_doneAfterTimeout(t *testing.T) {
+	v, err := Wait(ctx,
+		func(ctx context.Context) (string, error) {
+			// the fetch completes right after the timeout elapsed
+			<-ctx.Done()
+			return "ready", nil
+		},
+		func(v string) (bool, error) { return v == "ready", nil },
+		&WaitOptions{Timeout: time.Millisecond},
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ready", v)
+}
+
+func TestWait_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := Wait(ctx,
+		func(ctx context.Context) (int, error) { return 0, ctx.Err() },
+		func(v int) (bool, error) { return false, nil },
+		nil,
+	)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	var timeoutErr *WaitTimeoutError
+	assert.False(t, errors.As(err, &timeoutErr))
+}
+
+func TestDevices_WaitForPowerState(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var polls atomic.Int32
+	mux.HandleFunc("GET /devices/dev-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"identifier":"dev-1","isPoweredOn":%v}`, polls.Add(1) >= 2)
+	})
+
+	device, err := client.Devices.WaitForPowerState(ctx, "dev-1", true, &WaitOptions{Interval: time.Millisecond})
+
+	assert.NoError(t, err)
+	assert.True(t, device.PoweredOn)
+	assert.Equal(t, int32(2), polls.Load())
+}
+
+func TestKubernetes_WaitForStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var polls atomic.Int32
+	mux.HandleFunc("GET /kubernetes/kc-1", func(w http.ResponseWriter, r *http.Request) {
+		status := "provisioning"
+		if polls.Add(1) >= 2 {
+			status = "Ready"
+		}
+		_, _ = fmt.Fprintf(w, `{"data":{"identifier":"kc-1","status":%q}}`, status)
+	})
+
+	cluster, err := client.Kubernetes.WaitForStatus(ctx, "kc-1", KubernetesClusterStatusReady, &WaitOptions{Interval: time.Millisecond})
+
+	assert.NoError(t, err)
+	assert.Equal(t, KubernetesClusterStatus("Ready"), cluster.Status)
+}
+
+func TestLoadBalancerClusters_WaitForStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("GET /load-balancer-clusters/lbc-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"identifier":"lbc-1","status":"active"}`))
+	})
+
+	cluster, err := client.LoadBalancerClusters.WaitForStatus(ctx, "lbc-1", LoadBalancerClusterStatusActive, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, LoadBalancerClusterStatusActive, cluster.Status)
+
+	_, err = client.LoadBalancerClusters.WaitForStatus(ctx, "", LoadBalancerClusterStatusActive, nil)
+	assert.ErrorIs(t, err, ErrEmptyArgument)
+}
@@ -0,0 +1,12 @@
+// Package xelonmock provides mocks of the service interfaces of package xelon,
+// e.g. DevicesAPI for xelon.DevicesAPI. A mock method calls the function field
+// named after it and panics if the field is not set:
+//
+//	devices := &xelonmock.DevicesAPI{
+//		GetFunc: func(ctx context.Context, deviceID string) (*xelon.Device, *xelon.Response, error) {
+//			return &xelon.Device{ID: deviceID, PoweredOn: true}, nil, nil
+//		},
+//	}
+package xelonmock
+
+//go:generate go run ./internal/mockgen -source .. -output mocks.go
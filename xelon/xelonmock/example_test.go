@@ -0,0 +1,46 @@
+package xelonmock_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+	"github.com/Xelon-AG/xelon-sdk-go/xelon/xelonmock"
+)
+
+// startStopped starts all devices which are powered off.
+func startStopped(ctx context.Context, devices xelon.DevicesAPI, deviceIDs []string) (int, error) {
+	started := 0
+	for _, deviceID := range deviceIDs {
+		device, _, err := devices.Get(ctx, deviceID)
+		if err != nil {
+			return started, err
+		}
+		if device.PoweredOn {
+			continue
+		}
+		if _, err := devices.Start(ctx, deviceID); err != nil {
+			return started, err
+		}
+		started++
+	}
+	return started, nil
+}
+
+func Example() {
+	devices := &xelonmock.DevicesAPI{
+		GetFunc: func(ctx context.Context, deviceID string) (*xelon.Device, *xelon.Response, error) {
+			return &xelon.Device{ID: deviceID, PoweredOn: deviceID == "web-1"}, nil, nil
+		},
+		StartFunc: func(ctx context.Context, deviceID string) (*xelon.Response, error) {
+			fmt.Println("start", deviceID)
+			return nil, nil
+		},
+	}
+
+	started, err := startStopped(context.Background(), devices, []string{"web-1", "web-2"})
+	fmt.Println(started, err)
+	// Output:
+	// start web-2
+	// 1 <nil>
+}
@@ -0,0 +1,230 @@
+// Command mockgen generates mocks with function fields for the service interfaces
+// of package xelon, which are all exported interfaces with the "API" suffix.
+//
+// Usage:
+//
+//	go run ./internal/mockgen -source .. -output mocks.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	xelonImportPath = "github.com/Xelon-AG/xelon-sdk-go/xelon"
+	xelonPackage    = "xelon"
+)
+
+func main() {
+	source := flag.String("source", "..", "directory of package xelon")
+	output := flag.String("output", "mocks.go", "path of the generated file")
+	flag.Parse()
+
+	src, err := generate(*source)
+	if err != nil {
+		log.Fatalf("mockgen: %v", err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatalf("mockgen: %v", err)
+	}
+}
+
+// serviceInterface is a parsed service interface.
+type serviceInterface struct {
+	name    string
+	methods []*ast.Field
+}
+
+// generate returns the formatted source of the mocks for the service interfaces in dir.
+func generate(dir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var interfaces []serviceInterface
+	imports := make(map[string]string) // package name -> import path
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, spec := range file.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			name := importPath[strings.LastIndex(importPath, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = importPath
+		}
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				interfaceType, ok := typeSpec.Type.(*ast.InterfaceType)
+				if !ok || !typeSpec.Name.IsExported() || !strings.HasSuffix(typeSpec.Name.Name, "API") {
+					continue
+				}
+				interfaces = append(interfaces, serviceInterface{name: typeSpec.Name.Name, methods: interfaceType.Methods.List})
+			}
+		}
+	}
+	slices.SortFunc(interfaces, func(a, b serviceInterface) int { return strings.Compare(a.name, b.name) })
+
+	used := map[string]bool{xelonPackage: true}
+	var body bytes.Buffer
+	for _, iface := range interfaces {
+		if err := writeMock(&body, fset, iface, used); err != nil {
+			return nil, err
+		}
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by mockgen; DO NOT EDIT.\n\npackage xelonmock\n\nimport (\n")
+	var std, local []string
+	for name := range used {
+		importPath := imports[name]
+		if name == xelonPackage {
+			importPath = xelonImportPath
+		}
+		if importPath == "" {
+			return nil, fmt.Errorf("unknown package %q", name)
+		}
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			local = append(local, strconv.Quote(importPath))
+		} else {
+			std = append(std, strconv.Quote(importPath))
+		}
+	}
+	slices.Sort(std)
+	slices.Sort(local)
+	fmt.Fprintf(&src, "\t%v\n\n\t%v\n)\n", strings.Join(std, "\n\t"), strings.Join(local, "\n\t"))
+	src.Write(body.Bytes())
+
+	return format.Source(src.Bytes())
+}
+
+// writeMock writes the mock type of iface and records the used packages.
+func writeMock(w *bytes.Buffer, fset *token.FileSet, iface serviceInterface, used map[string]bool) error {
+	type method struct {
+		name      string
+		signature string // signature without the func keyword
+		args      string // arguments to forward the call
+		results   bool
+	}
+
+	var methods []method
+	for _, field := range iface.methods {
+		funcType, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) != 1 {
+			return fmt.Errorf("%v: embedded interfaces are not supported", iface.name)
+		}
+		qualify(funcType, used)
+
+		var params, args []string
+		for i, param := range funcType.Params.List {
+			paramType := exprString(fset, param.Type)
+			names := param.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+			}
+			for _, name := range names {
+				if name.Name == "m" {
+					return fmt.Errorf("%v.%v: parameter name m collides with the receiver", iface.name, field.Names[0].Name)
+				}
+				params = append(params, name.Name+" "+paramType)
+				arg := name.Name
+				if _, ok := param.Type.(*ast.Ellipsis); ok {
+					arg += "..."
+				}
+				args = append(args, arg)
+			}
+		}
+		signature := "(" + strings.Join(params, ", ") + ")"
+		if funcType.Results != nil {
+			var results []string
+			for _, result := range funcType.Results.List {
+				for range max(len(result.Names), 1) {
+					results = append(results, exprString(fset, result.Type))
+				}
+			}
+			if len(results) == 1 {
+				signature += " " + results[0]
+			} else {
+				signature += " (" + strings.Join(results, ", ") + ")"
+			}
+		}
+		methods = append(methods, method{
+			name:      field.Names[0].Name,
+			signature: signature,
+			args:      strings.Join(args, ", "),
+			results:   funcType.Results != nil,
+		})
+	}
+
+	fmt.Fprintf(w, "\n// %v is a mock of xelon.%[1]v.\ntype %[1]v struct {\n", iface.name)
+	for _, m := range methods {
+		fmt.Fprintf(w, "\t%vFunc func%v\n", m.name, m.signature)
+	}
+	fmt.Fprintf(w, "}\n\nvar _ xelon.%v = (*%[1]v)(nil)\n", iface.name)
+
+	for _, m := range methods {
+		ret := ""
+		if m.results {
+			ret = "return "
+		}
+		fmt.Fprintf(w, "\n// %[1]v calls %[1]vFunc.\nfunc (m *%[2]v) %[1]v%[3]v {\n", m.name, iface.name, m.signature)
+		fmt.Fprintf(w, "\tif m.%[1]vFunc == nil {\n\t\tpanic(\"xelonmock: %[2]v.%[1]v called but %[1]vFunc is not set\")\n\t}\n", m.name, iface.name)
+		fmt.Fprintf(w, "\t%vm.%vFunc(%v)\n}\n", ret, m.name, m.args)
+	}
+	return nil
+}
+
+// qualify prefixes the exported identifiers of package xelon in node with the package
+// name and records the packages of qualified identifiers in used.
+func qualify(node ast.Node, used map[string]bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Field:
+			// only the type of parameters and results is qualified, not their names
+			qualify(n.Type, used)
+			return false
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok {
+				used[x.Name] = true
+			}
+			return false
+		case *ast.Ident:
+			if n.IsExported() {
+				n.Name = xelonPackage + "." + n.Name
+			}
+		}
+		return true
+	})
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, fset, expr)
+	return buf.String()
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate_upToDate(t *testing.T) {
+	expected, err := os.ReadFile("../../mocks.go")
+	require.NoError(t, err)
+
+	actual, err := generate("../../..")
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expected), string(actual), "mocks are outdated, run go generate ./xelon/xelonmock")
+}
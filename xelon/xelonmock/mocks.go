@@ -0,0 +1,1705 @@
+// Code generated by mockgen; DO NOT EDIT.
+
+package xelonmock
+
+import (
+	"context"
+	"iter"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+// CloudsAPI is a mock of xelon.CloudsAPI.
+type CloudsAPI struct {
+	ListFunc func(ctx context.Context, opts *xelon.CloudListOptions) ([]xelon.Cloud, *xelon.Response, error)
+}
+
+var _ xelon.CloudsAPI = (*CloudsAPI)(nil)
+
+// List calls ListFunc.
+func (m *CloudsAPI) List(ctx context.Context, opts *xelon.CloudListOptions) ([]xelon.Cloud, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: CloudsAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// DevicesAPI is a mock of xelon.DevicesAPI.
+type DevicesAPI struct {
+	ListFunc                func(ctx context.Context, opts *xelon.DeviceListOptions) ([]xelon.Device, *xelon.Response, error)
+	AllFunc                 func(ctx context.Context, opts *xelon.DeviceListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Device, *xelon.Response], func() error)
+	GetFunc                 func(ctx context.Context, deviceID string) (*xelon.Device, *xelon.Response, error)
+	GetNetworkInfoFunc      func(ctx context.Context, deviceID string) ([]xelon.DeviceNetwork, *xelon.Response, error)
+	CreateFunc              func(ctx context.Context, createRequest *xelon.DeviceCreateRequest) (*xelon.Device, *xelon.Response, error)
+	CreateOrFindFunc        func(ctx context.Context, createRequest *xelon.DeviceCreateRequest) (*xelon.Device, *xelon.Response, error)
+	UpdateFunc              func(ctx context.Context, deviceID string, updateRequest *xelon.DeviceUpdateRequest) (*xelon.Device, *xelon.Response, error)
+	UpdateDiskFunc          func(ctx context.Context, deviceID string, updateRequest *xelon.DeviceUpdateDiskRequest) (*xelon.Device, *xelon.Response, error)
+	UpdateHardwareFunc      func(ctx context.Context, deviceID string, updateRequest *xelon.DeviceUpdateHardwareRequest) (*xelon.Device, *xelon.Response, error)
+	UpdateHotAddOptionsFunc func(ctx context.Context, deviceID string, updateRequest *xelon.DeviceUpdateHotAddRequest) (*xelon.Device, *xelon.Response, error)
+	DeleteFunc              func(ctx context.Context, deviceID string) (*xelon.Response, error)
+	DeleteManyFunc          func(ctx context.Context, deviceIDs []string, concurrency int) xelon.BulkResults[string]
+	StartFunc               func(ctx context.Context, deviceID string) (*xelon.Response, error)
+	StopFunc                func(ctx context.Context, deviceID string) (*xelon.Response, error)
+	RebootFunc              func(ctx context.Context, deviceID string, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error)
+	ResetFunc               func(ctx context.Context, deviceID string, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error)
+	ShutdownFunc            func(ctx context.Context, deviceID string, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error)
+	SuspendFunc             func(ctx context.Context, deviceID string, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error)
+	ResumeFunc              func(ctx context.Context, deviceID string, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error)
+	PowerFunc               func(ctx context.Context, deviceID string, action xelon.PowerAction, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error)
+	StartManyFunc           func(ctx context.Context, deviceIDs []string, concurrency int) xelon.BulkResults[string]
+	StopManyFunc            func(ctx context.Context, deviceIDs []string, concurrency int) xelon.BulkResults[string]
+	WaitForPowerStateFunc   func(ctx context.Context, deviceID string, poweredOn bool, opts *xelon.WaitOptions) (*xelon.Device, error)
+}
+
+var _ xelon.DevicesAPI = (*DevicesAPI)(nil)
+
+// List calls ListFunc.
+func (m *DevicesAPI) List(ctx context.Context, opts *xelon.DeviceListOptions) ([]xelon.Device, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: DevicesAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// All calls AllFunc.
+func (m *DevicesAPI) All(ctx context.Context, opts *xelon.DeviceListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Device, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: DevicesAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *DevicesAPI) Get(ctx context.Context, deviceID string) (*xelon.Device, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: DevicesAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, deviceID)
+}
+
+// GetNetworkInfo calls GetNetworkInfoFunc.
+func (m *DevicesAPI) GetNetworkInfo(ctx context.Context, deviceID string) ([]xelon.DeviceNetwork, *xelon.Response, error) {
+	if m.GetNetworkInfoFunc == nil {
+		panic("xelonmock: DevicesAPI.GetNetworkInfo called but GetNetworkInfoFunc is not set")
+	}
+	return m.GetNetworkInfoFunc(ctx, deviceID)
+}
+
+// Create calls CreateFunc.
+func (m *DevicesAPI) Create(ctx context.Context, createRequest *xelon.DeviceCreateRequest) (*xelon.Device, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: DevicesAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, createRequest)
+}
+
+// CreateOrFind calls CreateOrFindFunc.
+func (m *DevicesAPI) CreateOrFind(ctx context.Context, createRequest *xelon.DeviceCreateRequest) (*xelon.Device, *xelon.Response, error) {
+	if m.CreateOrFindFunc == nil {
+		panic("xelonmock: DevicesAPI.CreateOrFind called but CreateOrFindFunc is not set")
+	}
+	return m.CreateOrFindFunc(ctx, createRequest)
+}
+
+// Update calls UpdateFunc.
+func (m *DevicesAPI) Update(ctx context.Context, deviceID string, updateRequest *xelon.DeviceUpdateRequest) (*xelon.Device, *xelon.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("xelonmock: DevicesAPI.Update called but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, deviceID, updateRequest)
+}
+
+// UpdateDisk calls UpdateDiskFunc.
+func (m *DevicesAPI) UpdateDisk(ctx context.Context, deviceID string, updateRequest *xelon.DeviceUpdateDiskRequest) (*xelon.Device, *xelon.Response, error) {
+	if m.UpdateDiskFunc == nil {
+		panic("xelonmock: DevicesAPI.UpdateDisk called but UpdateDiskFunc is not set")
+	}
+	return m.UpdateDiskFunc(ctx, deviceID, updateRequest)
+}
+
+// UpdateHardware calls UpdateHardwareFunc.
+func (m *DevicesAPI) UpdateHardware(ctx context.Context, deviceID string, updateRequest *xelon.DeviceUpdateHardwareRequest) (*xelon.Device, *xelon.Response, error) {
+	if m.UpdateHardwareFunc == nil {
+		panic("xelonmock: DevicesAPI.UpdateHardware called but UpdateHardwareFunc is not set")
+	}
+	return m.UpdateHardwareFunc(ctx, deviceID, updateRequest)
+}
+
+// UpdateHotAddOptions calls UpdateHotAddOptionsFunc.
+func (m *DevicesAPI) UpdateHotAddOptions(ctx context.Context, deviceID string, updateRequest *xelon.DeviceUpdateHotAddRequest) (*xelon.Device, *xelon.Response, error) {
+	if m.UpdateHotAddOptionsFunc == nil {
+		panic("xelonmock: DevicesAPI.UpdateHotAddOptions called but UpdateHotAddOptionsFunc is not set")
+	}
+	return m.UpdateHotAddOptionsFunc(ctx, deviceID, updateRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *DevicesAPI) Delete(ctx context.Context, deviceID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: DevicesAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, deviceID)
+}
+
+// DeleteMany calls DeleteManyFunc.
+func (m *DevicesAPI) DeleteMany(ctx context.Context, deviceIDs []string, concurrency int) xelon.BulkResults[string] {
+	if m.DeleteManyFunc == nil {
+		panic("xelonmock: DevicesAPI.DeleteMany called but DeleteManyFunc is not set")
+	}
+	return m.DeleteManyFunc(ctx, deviceIDs, concurrency)
+}
+
+// Start calls StartFunc.
+func (m *DevicesAPI) Start(ctx context.Context, deviceID string) (*xelon.Response, error) {
+	if m.StartFunc == nil {
+		panic("xelonmock: DevicesAPI.Start called but StartFunc is not set")
+	}
+	return m.StartFunc(ctx, deviceID)
+}
+
+// Stop calls StopFunc.
+func (m *DevicesAPI) Stop(ctx context.Context, deviceID string) (*xelon.Response, error) {
+	if m.StopFunc == nil {
+		panic("xelonmock: DevicesAPI.Stop called but StopFunc is not set")
+	}
+	return m.StopFunc(ctx, deviceID)
+}
+
+// Reboot calls RebootFunc.
+func (m *DevicesAPI) Reboot(ctx context.Context, deviceID string, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error) {
+	if m.RebootFunc == nil {
+		panic("xelonmock: DevicesAPI.Reboot called but RebootFunc is not set")
+	}
+	return m.RebootFunc(ctx, deviceID, opts)
+}
+
+// Reset calls ResetFunc.
+func (m *DevicesAPI) Reset(ctx context.Context, deviceID string, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error) {
+	if m.ResetFunc == nil {
+		panic("xelonmock: DevicesAPI.Reset called but ResetFunc is not set")
+	}
+	return m.ResetFunc(ctx, deviceID, opts)
+}
+
+// Shutdown calls ShutdownFunc.
+func (m *DevicesAPI) Shutdown(ctx context.Context, deviceID string, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error) {
+	if m.ShutdownFunc == nil {
+		panic("xelonmock: DevicesAPI.Shutdown called but ShutdownFunc is not set")
+	}
+	return m.ShutdownFunc(ctx, deviceID, opts)
+}
+
+// Suspend calls SuspendFunc.
+func (m *DevicesAPI) Suspend(ctx context.Context, deviceID string, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error) {
+	if m.SuspendFunc == nil {
+		panic("xelonmock: DevicesAPI.Suspend called but SuspendFunc is not set")
+	}
+	return m.SuspendFunc(ctx, deviceID, opts)
+}
+
+// Resume calls ResumeFunc.
+func (m *DevicesAPI) Resume(ctx context.Context, deviceID string, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error) {
+	if m.ResumeFunc == nil {
+		panic("xelonmock: DevicesAPI.Resume called but ResumeFunc is not set")
+	}
+	return m.ResumeFunc(ctx, deviceID, opts)
+}
+
+// Power calls PowerFunc.
+func (m *DevicesAPI) Power(ctx context.Context, deviceID string, action xelon.PowerAction, opts *xelon.PowerOptions) (*xelon.Device, *xelon.Response, error) {
+	if m.PowerFunc == nil {
+		panic("xelonmock: DevicesAPI.Power called but PowerFunc is not set")
+	}
+	return m.PowerFunc(ctx, deviceID, action, opts)
+}
+
+// StartMany calls StartManyFunc.
+func (m *DevicesAPI) StartMany(ctx context.Context, deviceIDs []string, concurrency int) xelon.BulkResults[string] {
+	if m.StartManyFunc == nil {
+		panic("xelonmock: DevicesAPI.StartMany called but StartManyFunc is not set")
+	}
+	return m.StartManyFunc(ctx, deviceIDs, concurrency)
+}
+
+// StopMany calls StopManyFunc.
+func (m *DevicesAPI) StopMany(ctx context.Context, deviceIDs []string, concurrency int) xelon.BulkResults[string] {
+	if m.StopManyFunc == nil {
+		panic("xelonmock: DevicesAPI.StopMany called but StopManyFunc is not set")
+	}
+	return m.StopManyFunc(ctx, deviceIDs, concurrency)
+}
+
+// WaitForPowerState calls WaitForPowerStateFunc.
+func (m *DevicesAPI) WaitForPowerState(ctx context.Context, deviceID string, poweredOn bool, opts *xelon.WaitOptions) (*xelon.Device, error) {
+	if m.WaitForPowerStateFunc == nil {
+		panic("xelonmock: DevicesAPI.WaitForPowerState called but WaitForPowerStateFunc is not set")
+	}
+	return m.WaitForPowerStateFunc(ctx, deviceID, poweredOn, opts)
+}
+
+// DomainsAPI is a mock of xelon.DomainsAPI.
+type DomainsAPI struct {
+	ListZonesFunc    func(ctx context.Context, opts *xelon.DNSZoneListOptions) ([]xelon.DNSZone, *xelon.Response, error)
+	AllZonesFunc     func(ctx context.Context, opts *xelon.DNSZoneListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.DNSZone, *xelon.Response], func() error)
+	GetZoneFunc      func(ctx context.Context, dnsZoneID string) (*xelon.DNSZone, *xelon.Response, error)
+	CreateZoneFunc   func(ctx context.Context, createRequest *xelon.DNSZoneCreateRequest) (*xelon.DNSZone, *xelon.Response, error)
+	DeleteZoneFunc   func(ctx context.Context, dnsZoneID string) (*xelon.Response, error)
+	ListRecordsFunc  func(ctx context.Context, dnsZoneID string) ([]xelon.DNSRecord, *xelon.Response, error)
+	CreateRecordFunc func(ctx context.Context, dnsZoneID string, createRequest *xelon.DNSRecordCreateRequest) (*xelon.Response, error)
+	UpdateRecordFunc func(ctx context.Context, dnsZoneID string, dnsRecordID int, updateRequest *xelon.DNSRecordUpdateRequest) (*xelon.Response, error)
+	DeleteRecordFunc func(ctx context.Context, dnsZoneID string, dnsRecordID int) (*xelon.Response, error)
+	GetSOAFunc       func(ctx context.Context, dnsZoneID string) (*xelon.DNSSOA, *xelon.Response, error)
+	UpdateSOAFunc    func(ctx context.Context, dnsZoneID string, updateRequest *xelon.DNSSOAUpdateRequest) (*xelon.Response, error)
+}
+
+var _ xelon.DomainsAPI = (*DomainsAPI)(nil)
+
+// ListZones calls ListZonesFunc.
+func (m *DomainsAPI) ListZones(ctx context.Context, opts *xelon.DNSZoneListOptions) ([]xelon.DNSZone, *xelon.Response, error) {
+	if m.ListZonesFunc == nil {
+		panic("xelonmock: DomainsAPI.ListZones called but ListZonesFunc is not set")
+	}
+	return m.ListZonesFunc(ctx, opts)
+}
+
+// AllZones calls AllZonesFunc.
+func (m *DomainsAPI) AllZones(ctx context.Context, opts *xelon.DNSZoneListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.DNSZone, *xelon.Response], func() error) {
+	if m.AllZonesFunc == nil {
+		panic("xelonmock: DomainsAPI.AllZones called but AllZonesFunc is not set")
+	}
+	return m.AllZonesFunc(ctx, opts, paginatorOpts...)
+}
+
+// GetZone calls GetZoneFunc.
+func (m *DomainsAPI) GetZone(ctx context.Context, dnsZoneID string) (*xelon.DNSZone, *xelon.Response, error) {
+	if m.GetZoneFunc == nil {
+		panic("xelonmock: DomainsAPI.GetZone called but GetZoneFunc is not set")
+	}
+	return m.GetZoneFunc(ctx, dnsZoneID)
+}
+
+// CreateZone calls CreateZoneFunc.
+func (m *DomainsAPI) CreateZone(ctx context.Context, createRequest *xelon.DNSZoneCreateRequest) (*xelon.DNSZone, *xelon.Response, error) {
+	if m.CreateZoneFunc == nil {
+		panic("xelonmock: DomainsAPI.CreateZone called but CreateZoneFunc is not set")
+	}
+	return m.CreateZoneFunc(ctx, createRequest)
+}
+
+// DeleteZone calls DeleteZoneFunc.
+func (m *DomainsAPI) DeleteZone(ctx context.Context, dnsZoneID string) (*xelon.Response, error) {
+	if m.DeleteZoneFunc == nil {
+		panic("xelonmock: DomainsAPI.DeleteZone called but DeleteZoneFunc is not set")
+	}
+	return m.DeleteZoneFunc(ctx, dnsZoneID)
+}
+
+// ListRecords calls ListRecordsFunc.
+func (m *DomainsAPI) ListRecords(ctx context.Context, dnsZoneID string) ([]xelon.DNSRecord, *xelon.Response, error) {
+	if m.ListRecordsFunc == nil {
+		panic("xelonmock: DomainsAPI.ListRecords called but ListRecordsFunc is not set")
+	}
+	return m.ListRecordsFunc(ctx, dnsZoneID)
+}
+
+// CreateRecord calls CreateRecordFunc.
+func (m *DomainsAPI) CreateRecord(ctx context.Context, dnsZoneID string, createRequest *xelon.DNSRecordCreateRequest) (*xelon.Response, error) {
+	if m.CreateRecordFunc == nil {
+		panic("xelonmock: DomainsAPI.CreateRecord called but CreateRecordFunc is not set")
+	}
+	return m.CreateRecordFunc(ctx, dnsZoneID, createRequest)
+}
+
+// UpdateRecord calls UpdateRecordFunc.
+func (m *DomainsAPI) UpdateRecord(ctx context.Context, dnsZoneID string, dnsRecordID int, updateRequest *xelon.DNSRecordUpdateRequest) (*xelon.Response, error) {
+	if m.UpdateRecordFunc == nil {
+		panic("xelonmock: DomainsAPI.UpdateRecord called but UpdateRecordFunc is not set")
+	}
+	return m.UpdateRecordFunc(ctx, dnsZoneID, dnsRecordID, updateRequest)
+}
+
+// DeleteRecord calls DeleteRecordFunc.
+func (m *DomainsAPI) DeleteRecord(ctx context.Context, dnsZoneID string, dnsRecordID int) (*xelon.Response, error) {
+	if m.DeleteRecordFunc == nil {
+		panic("xelonmock: DomainsAPI.DeleteRecord called but DeleteRecordFunc is not set")
+	}
+	return m.DeleteRecordFunc(ctx, dnsZoneID, dnsRecordID)
+}
+
+// GetSOA calls GetSOAFunc.
+func (m *DomainsAPI) GetSOA(ctx context.Context, dnsZoneID string) (*xelon.DNSSOA, *xelon.Response, error) {
+	if m.GetSOAFunc == nil {
+		panic("xelonmock: DomainsAPI.GetSOA called but GetSOAFunc is not set")
+	}
+	return m.GetSOAFunc(ctx, dnsZoneID)
+}
+
+// UpdateSOA calls UpdateSOAFunc.
+func (m *DomainsAPI) UpdateSOA(ctx context.Context, dnsZoneID string, updateRequest *xelon.DNSSOAUpdateRequest) (*xelon.Response, error) {
+	if m.UpdateSOAFunc == nil {
+		panic("xelonmock: DomainsAPI.UpdateSOA called but UpdateSOAFunc is not set")
+	}
+	return m.UpdateSOAFunc(ctx, dnsZoneID, updateRequest)
+}
+
+// FirewallsAPI is a mock of xelon.FirewallsAPI.
+type FirewallsAPI struct {
+	ListFunc                 func(ctx context.Context, opts *xelon.FirewallListOptions) ([]xelon.Firewall, *xelon.Response, error)
+	AllFunc                  func(ctx context.Context, opts *xelon.FirewallListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Firewall, *xelon.Response], func() error)
+	GetFunc                  func(ctx context.Context, firewallID string) (*xelon.Firewall, *xelon.Response, error)
+	CreateFunc               func(ctx context.Context, createRequest *xelon.FirewallCreateRequest) (*xelon.Firewall, *xelon.Response, error)
+	UpdateFunc               func(ctx context.Context, firewallID string, updateRequest *xelon.FirewallUpdateRequest) (*xelon.Firewall, *xelon.Response, error)
+	DeleteFunc               func(ctx context.Context, firewallID string) (*xelon.Response, error)
+	CreateForwardingRuleFunc func(ctx context.Context, firewallID string, createRequest *xelon.FirewallCreateForwardingRuleRequest) (*xelon.FirewallForwardingRule, *xelon.Response, error)
+	UpdateForwardingRuleFunc func(ctx context.Context, firewallID string, forwardingRuleID string, updateRequest *xelon.FirewallUpdateForwardingRuleRequest) (*xelon.FirewallForwardingRule, *xelon.Response, error)
+	DeleteForwardingRuleFunc func(ctx context.Context, firewallID string, forwardingRuleID string) (*xelon.Response, error)
+}
+
+var _ xelon.FirewallsAPI = (*FirewallsAPI)(nil)
+
+// List calls ListFunc.
+func (m *FirewallsAPI) List(ctx context.Context, opts *xelon.FirewallListOptions) ([]xelon.Firewall, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: FirewallsAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// All calls AllFunc.
+func (m *FirewallsAPI) All(ctx context.Context, opts *xelon.FirewallListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Firewall, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: FirewallsAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *FirewallsAPI) Get(ctx context.Context, firewallID string) (*xelon.Firewall, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: FirewallsAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, firewallID)
+}
+
+// Create calls CreateFunc.
+func (m *FirewallsAPI) Create(ctx context.Context, createRequest *xelon.FirewallCreateRequest) (*xelon.Firewall, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: FirewallsAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, createRequest)
+}
+
+// Update calls UpdateFunc.
+func (m *FirewallsAPI) Update(ctx context.Context, firewallID string, updateRequest *xelon.FirewallUpdateRequest) (*xelon.Firewall, *xelon.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("xelonmock: FirewallsAPI.Update called but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, firewallID, updateRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *FirewallsAPI) Delete(ctx context.Context, firewallID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: FirewallsAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, firewallID)
+}
+
+// CreateForwardingRule calls CreateForwardingRuleFunc.
+func (m *FirewallsAPI) CreateForwardingRule(ctx context.Context, firewallID string, createRequest *xelon.FirewallCreateForwardingRuleRequest) (*xelon.FirewallForwardingRule, *xelon.Response, error) {
+	if m.CreateForwardingRuleFunc == nil {
+		panic("xelonmock: FirewallsAPI.CreateForwardingRule called but CreateForwardingRuleFunc is not set")
+	}
+	return m.CreateForwardingRuleFunc(ctx, firewallID, createRequest)
+}
+
+// UpdateForwardingRule calls UpdateForwardingRuleFunc.
+func (m *FirewallsAPI) UpdateForwardingRule(ctx context.Context, firewallID string, forwardingRuleID string, updateRequest *xelon.FirewallUpdateForwardingRuleRequest) (*xelon.FirewallForwardingRule, *xelon.Response, error) {
+	if m.UpdateForwardingRuleFunc == nil {
+		panic("xelonmock: FirewallsAPI.UpdateForwardingRule called but UpdateForwardingRuleFunc is not set")
+	}
+	return m.UpdateForwardingRuleFunc(ctx, firewallID, forwardingRuleID, updateRequest)
+}
+
+// DeleteForwardingRule calls DeleteForwardingRuleFunc.
+func (m *FirewallsAPI) DeleteForwardingRule(ctx context.Context, firewallID string, forwardingRuleID string) (*xelon.Response, error) {
+	if m.DeleteForwardingRuleFunc == nil {
+		panic("xelonmock: FirewallsAPI.DeleteForwardingRule called but DeleteForwardingRuleFunc is not set")
+	}
+	return m.DeleteForwardingRuleFunc(ctx, firewallID, forwardingRuleID)
+}
+
+// ISOsAPI is a mock of xelon.ISOsAPI.
+type ISOsAPI struct {
+	ListFunc   func(ctx context.Context, opts *xelon.ISOListOptions) ([]xelon.ISO, *xelon.Response, error)
+	AllFunc    func(ctx context.Context, opts *xelon.ISOListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.ISO, *xelon.Response], func() error)
+	GetFunc    func(ctx context.Context, isoID string) (*xelon.ISO, *xelon.Response, error)
+	CreateFunc func(ctx context.Context, createRequest *xelon.ISOCreateRequest) (*xelon.ISO, *xelon.Response, error)
+	UpdateFunc func(ctx context.Context, isoID string, updateRequest *xelon.ISOUpdateRequest) (*xelon.ISO, *xelon.Response, error)
+	DeleteFunc func(ctx context.Context, isoID string) (*xelon.Response, error)
+}
+
+var _ xelon.ISOsAPI = (*ISOsAPI)(nil)
+
+// List calls ListFunc.
+func (m *ISOsAPI) List(ctx context.Context, opts *xelon.ISOListOptions) ([]xelon.ISO, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: ISOsAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// All calls AllFunc.
+func (m *ISOsAPI) All(ctx context.Context, opts *xelon.ISOListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.ISO, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: ISOsAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *ISOsAPI) Get(ctx context.Context, isoID string) (*xelon.ISO, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: ISOsAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, isoID)
+}
+
+// Create calls CreateFunc.
+func (m *ISOsAPI) Create(ctx context.Context, createRequest *xelon.ISOCreateRequest) (*xelon.ISO, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: ISOsAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, createRequest)
+}
+
+// Update calls UpdateFunc.
+func (m *ISOsAPI) Update(ctx context.Context, isoID string, updateRequest *xelon.ISOUpdateRequest) (*xelon.ISO, *xelon.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("xelonmock: ISOsAPI.Update called but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, isoID, updateRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *ISOsAPI) Delete(ctx context.Context, isoID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: ISOsAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, isoID)
+}
+
+// KubernetesAPI is a mock of xelon.KubernetesAPI.
+type KubernetesAPI struct {
+	ListFunc                     func(ctx context.Context, opts *xelon.ListOptions) ([]xelon.KubernetesCluster, *xelon.Response, error)
+	AllFunc                      func(ctx context.Context, opts *xelon.ListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.KubernetesCluster, *xelon.Response], func() error)
+	GetFunc                      func(ctx context.Context, kubernetesClusterID string) (*xelon.KubernetesCluster, *xelon.Response, error)
+	CreateFunc                   func(ctx context.Context, createRequest *xelon.KubernetesClusterCreateRequest) (*xelon.KubernetesCluster, *xelon.Response, error)
+	CreateOrFindFunc             func(ctx context.Context, createRequest *xelon.KubernetesClusterCreateRequest) (*xelon.KubernetesCluster, *xelon.Response, error)
+	DeleteFunc                   func(ctx context.Context, kubernetesClusterID string) (*xelon.Response, error)
+	UpgradeHighAvailabilityFunc  func(ctx context.Context, kubernetesClusterID string) (*xelon.Response, error)
+	GetKubeconfigFunc            func(ctx context.Context, kubernetesClusterID string) ([]byte, *xelon.Response, error)
+	GetTalosconfigFunc           func(ctx context.Context, kubernetesClusterID string) ([]byte, *xelon.Response, error)
+	ListControlPlaneFunc         func(ctx context.Context, kubernetesClusterID string) (*xelon.KubernetesClusterControlPlane, *xelon.Response, error)
+	UpdateControlPlaneFunc       func(ctx context.Context, kubernetesClusterID string, updateRequest *xelon.KubernetesClusterControlPlaneUpdateRequest) (*xelon.Response, error)
+	ListNodePoolsFunc            func(ctx context.Context, kubernetesClusterID string) ([]xelon.KubernetesClusterNodePool, *xelon.Response, error)
+	GetNodePoolFunc              func(ctx context.Context, kubernetesClusterID string, nodePoolID string) (*xelon.KubernetesClusterNodePool, *xelon.Response, error)
+	CreateNodePoolFunc           func(ctx context.Context, kubernetesClusterID string, createRequest *xelon.KubernetesClusterNodePoolCreateRequest) (*xelon.KubernetesClusterNodePool, *xelon.Response, error)
+	UpdateNodePoolFunc           func(ctx context.Context, kubernetesClusterID string, nodePoolID string, updateRequest *xelon.KubernetesClusterNodePoolUpdateRequest) (*xelon.Response, error)
+	DeleteNodePoolFunc           func(ctx context.Context, kubernetesClusterID string, nodePoolID string) (*xelon.Response, error)
+	CreateNodeFunc               func(ctx context.Context, kubernetesClusterID string, nodePoolID string) (*xelon.Response, error)
+	DeleteNodeFunc               func(ctx context.Context, kubernetesClusterID string, nodeID string) (*xelon.Response, error)
+	ListLoadBalancerFunc         func(ctx context.Context, kubernetesClusterID string) (*xelon.KubernetesClusterLoadBalancer, *xelon.Response, error)
+	ListVersionMappingFunc       func(ctx context.Context, cloudID string) (xelon.KubernetesClusterVersionMapping, *xelon.Response, error)
+	UpgradeKubernetesVersionFunc func(ctx context.Context, kubernetesClusterID string, upgradeRequest *xelon.KubernetesClusterVersionUpgradeRequest) (*xelon.Response, error)
+	UpgradeTalosVersionFunc      func(ctx context.Context, kubernetesClusterID string, upgradeRequest *xelon.KubernetesClusterVersionUpgradeRequest) (*xelon.Response, error)
+	WaitForStatusFunc            func(ctx context.Context, kubernetesClusterID string, status xelon.KubernetesClusterStatus, opts *xelon.WaitOptions) (*xelon.KubernetesCluster, error)
+}
+
+var _ xelon.KubernetesAPI = (*KubernetesAPI)(nil)
+
+// List calls ListFunc.
+func (m *KubernetesAPI) List(ctx context.Context, opts *xelon.ListOptions) ([]xelon.KubernetesCluster, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: KubernetesAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// All calls AllFunc.
+func (m *KubernetesAPI) All(ctx context.Context, opts *xelon.ListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.KubernetesCluster, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: KubernetesAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *KubernetesAPI) Get(ctx context.Context, kubernetesClusterID string) (*xelon.KubernetesCluster, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: KubernetesAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, kubernetesClusterID)
+}
+
+// Create calls CreateFunc.
+func (m *KubernetesAPI) Create(ctx context.Context, createRequest *xelon.KubernetesClusterCreateRequest) (*xelon.KubernetesCluster, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: KubernetesAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, createRequest)
+}
+
+// CreateOrFind calls CreateOrFindFunc.
+func (m *KubernetesAPI) CreateOrFind(ctx context.Context, createRequest *xelon.KubernetesClusterCreateRequest) (*xelon.KubernetesCluster, *xelon.Response, error) {
+	if m.CreateOrFindFunc == nil {
+		panic("xelonmock: KubernetesAPI.CreateOrFind called but CreateOrFindFunc is not set")
+	}
+	return m.CreateOrFindFunc(ctx, createRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *KubernetesAPI) Delete(ctx context.Context, kubernetesClusterID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: KubernetesAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, kubernetesClusterID)
+}
+
+// UpgradeHighAvailability calls UpgradeHighAvailabilityFunc.
+func (m *KubernetesAPI) UpgradeHighAvailability(ctx context.Context, kubernetesClusterID string) (*xelon.Response, error) {
+	if m.UpgradeHighAvailabilityFunc == nil {
+		panic("xelonmock: KubernetesAPI.UpgradeHighAvailability called but UpgradeHighAvailabilityFunc is not set")
+	}
+	return m.UpgradeHighAvailabilityFunc(ctx, kubernetesClusterID)
+}
+
+// GetKubeconfig calls GetKubeconfigFunc.
+func (m *KubernetesAPI) GetKubeconfig(ctx context.Context, kubernetesClusterID string) ([]byte, *xelon.Response, error) {
+	if m.GetKubeconfigFunc == nil {
+		panic("xelonmock: KubernetesAPI.GetKubeconfig called but GetKubeconfigFunc is not set")
+	}
+	return m.GetKubeconfigFunc(ctx, kubernetesClusterID)
+}
+
+// GetTalosconfig calls GetTalosconfigFunc.
+func (m *KubernetesAPI) GetTalosconfig(ctx context.Context, kubernetesClusterID string) ([]byte, *xelon.Response, error) {
+	if m.GetTalosconfigFunc == nil {
+		panic("xelonmock: KubernetesAPI.GetTalosconfig called but GetTalosconfigFunc is not set")
+	}
+	return m.GetTalosconfigFunc(ctx, kubernetesClusterID)
+}
+
+// ListControlPlane calls ListControlPlaneFunc.
+func (m *KubernetesAPI) ListControlPlane(ctx context.Context, kubernetesClusterID string) (*xelon.KubernetesClusterControlPlane, *xelon.Response, error) {
+	if m.ListControlPlaneFunc == nil {
+		panic("xelonmock: KubernetesAPI.ListControlPlane called but ListControlPlaneFunc is not set")
+	}
+	return m.ListControlPlaneFunc(ctx, kubernetesClusterID)
+}
+
+// UpdateControlPlane calls UpdateControlPlaneFunc.
+func (m *KubernetesAPI) UpdateControlPlane(ctx context.Context, kubernetesClusterID string, updateRequest *xelon.KubernetesClusterControlPlaneUpdateRequest) (*xelon.Response, error) {
+	if m.UpdateControlPlaneFunc == nil {
+		panic("xelonmock: KubernetesAPI.UpdateControlPlane called but UpdateControlPlaneFunc is not set")
+	}
+	return m.UpdateControlPlaneFunc(ctx, kubernetesClusterID, updateRequest)
+}
+
+// ListNodePools calls ListNodePoolsFunc.
+func (m *KubernetesAPI) ListNodePools(ctx context.Context, kubernetesClusterID string) ([]xelon.KubernetesClusterNodePool, *xelon.Response, error) {
+	if m.ListNodePoolsFunc == nil {
+		panic("xelonmock: KubernetesAPI.ListNodePools called but ListNodePoolsFunc is not set")
+	}
+	return m.ListNodePoolsFunc(ctx, kubernetesClusterID)
+}
+
+// GetNodePool calls GetNodePoolFunc.
+func (m *KubernetesAPI) GetNodePool(ctx context.Context, kubernetesClusterID string, nodePoolID string) (*xelon.KubernetesClusterNodePool, *xelon.Response, error) {
+	if m.GetNodePoolFunc == nil {
+		panic("xelonmock: KubernetesAPI.GetNodePool called but GetNodePoolFunc is not set")
+	}
+	return m.GetNodePoolFunc(ctx, kubernetesClusterID, nodePoolID)
+}
+
+// CreateNodePool calls CreateNodePoolFunc.
+func (m *KubernetesAPI) CreateNodePool(ctx context.Context, kubernetesClusterID string, createRequest *xelon.KubernetesClusterNodePoolCreateRequest) (*xelon.KubernetesClusterNodePool, *xelon.Response, error) {
+	if m.CreateNodePoolFunc == nil {
+		panic("xelonmock: KubernetesAPI.CreateNodePool called but CreateNodePoolFunc is not set")
+	}
+	return m.CreateNodePoolFunc(ctx, kubernetesClusterID, createRequest)
+}
+
+// UpdateNodePool calls UpdateNodePoolFunc.
+func (m *KubernetesAPI) UpdateNodePool(ctx context.Context, kubernetesClusterID string, nodePoolID string, updateRequest *xelon.KubernetesClusterNodePoolUpdateRequest) (*xelon.Response, error) {
+	if m.UpdateNodePoolFunc == nil {
+		panic("xelonmock: KubernetesAPI.UpdateNodePool called but UpdateNodePoolFunc is not set")
+	}
+	return m.UpdateNodePoolFunc(ctx, kubernetesClusterID, nodePoolID, updateRequest)
+}
+
+// DeleteNodePool calls DeleteNodePoolFunc.
+func (m *KubernetesAPI) DeleteNodePool(ctx context.Context, kubernetesClusterID string, nodePoolID string) (*xelon.Response, error) {
+	if m.DeleteNodePoolFunc == nil {
+		panic("xelonmock: KubernetesAPI.DeleteNodePool called but DeleteNodePoolFunc is not set")
+	}
+	return m.DeleteNodePoolFunc(ctx, kubernetesClusterID, nodePoolID)
+}
+
+// CreateNode calls CreateNodeFunc.
+func (m *KubernetesAPI) CreateNode(ctx context.Context, kubernetesClusterID string, nodePoolID string) (*xelon.Response, error) {
+	if m.CreateNodeFunc == nil {
+		panic("xelonmock: KubernetesAPI.CreateNode called but CreateNodeFunc is not set")
+	}
+	return m.CreateNodeFunc(ctx, kubernetesClusterID, nodePoolID)
+}
+
+// DeleteNode calls DeleteNodeFunc.
+func (m *KubernetesAPI) DeleteNode(ctx context.Context, kubernetesClusterID string, nodeID string) (*xelon.Response, error) {
+	if m.DeleteNodeFunc == nil {
+		panic("xelonmock: KubernetesAPI.DeleteNode called but DeleteNodeFunc is not set")
+	}
+	return m.DeleteNodeFunc(ctx, kubernetesClusterID, nodeID)
+}
+
+// ListLoadBalancer calls ListLoadBalancerFunc.
+func (m *KubernetesAPI) ListLoadBalancer(ctx context.Context, kubernetesClusterID string) (*xelon.KubernetesClusterLoadBalancer, *xelon.Response, error) {
+	if m.ListLoadBalancerFunc == nil {
+		panic("xelonmock: KubernetesAPI.ListLoadBalancer called but ListLoadBalancerFunc is not set")
+	}
+	return m.ListLoadBalancerFunc(ctx, kubernetesClusterID)
+}
+
+// ListVersionMapping calls ListVersionMappingFunc.
+func (m *KubernetesAPI) ListVersionMapping(ctx context.Context, cloudID string) (xelon.KubernetesClusterVersionMapping, *xelon.Response, error) {
+	if m.ListVersionMappingFunc == nil {
+		panic("xelonmock: KubernetesAPI.ListVersionMapping called but ListVersionMappingFunc is not set")
+	}
+	return m.ListVersionMappingFunc(ctx, cloudID)
+}
+
+// UpgradeKubernetesVersion calls UpgradeKubernetesVersionFunc.
+func (m *KubernetesAPI) UpgradeKubernetesVersion(ctx context.Context, kubernetesClusterID string, upgradeRequest *xelon.KubernetesClusterVersionUpgradeRequest) (*xelon.Response, error) {
+	if m.UpgradeKubernetesVersionFunc == nil {
+		panic("xelonmock: KubernetesAPI.UpgradeKubernetesVersion called but UpgradeKubernetesVersionFunc is not set")
+	}
+	return m.UpgradeKubernetesVersionFunc(ctx, kubernetesClusterID, upgradeRequest)
+}
+
+// UpgradeTalosVersion calls UpgradeTalosVersionFunc.
+func (m *KubernetesAPI) UpgradeTalosVersion(ctx context.Context, kubernetesClusterID string, upgradeRequest *xelon.KubernetesClusterVersionUpgradeRequest) (*xelon.Response, error) {
+	if m.UpgradeTalosVersionFunc == nil {
+		panic("xelonmock: KubernetesAPI.UpgradeTalosVersion called but UpgradeTalosVersionFunc is not set")
+	}
+	return m.UpgradeTalosVersionFunc(ctx, kubernetesClusterID, upgradeRequest)
+}
+
+// WaitForStatus calls WaitForStatusFunc.
+func (m *KubernetesAPI) WaitForStatus(ctx context.Context, kubernetesClusterID string, status xelon.KubernetesClusterStatus, opts *xelon.WaitOptions) (*xelon.KubernetesCluster, error) {
+	if m.WaitForStatusFunc == nil {
+		panic("xelonmock: KubernetesAPI.WaitForStatus called but WaitForStatusFunc is not set")
+	}
+	return m.WaitForStatusFunc(ctx, kubernetesClusterID, status, opts)
+}
+
+// KubernetesTalosAPI is a mock of xelon.KubernetesTalosAPI.
+type KubernetesTalosAPI struct {
+	ListFunc              func(ctx context.Context) ([]xelon.KubernetesTalosCluster, *xelon.Response, error)
+	ListControlPlanesFunc func(ctx context.Context, kubernetesClusterID string) (*xelon.ClusterControlPlane, *xelon.Response, error)
+	ListClusterPoolsFunc  func(ctx context.Context, kubernetesClusterID string) ([]xelon.ClusterPool, *xelon.Response, error)
+	AddClusterNodeFunc    func(ctx context.Context, kubernetesClusterID string, clusterPoolID string) (*xelon.SuccessResponse, *xelon.Response, error)
+	DeleteClusterNodeFunc func(ctx context.Context, kubernetesClusterID string, clusterNodeID string) (*xelon.SuccessResponse, *xelon.Response, error)
+}
+
+var _ xelon.KubernetesTalosAPI = (*KubernetesTalosAPI)(nil)
+
+// List calls ListFunc.
+func (m *KubernetesTalosAPI) List(ctx context.Context) ([]xelon.KubernetesTalosCluster, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: KubernetesTalosAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx)
+}
+
+// ListControlPlanes calls ListControlPlanesFunc.
+func (m *KubernetesTalosAPI) ListControlPlanes(ctx context.Context, kubernetesClusterID string) (*xelon.ClusterControlPlane, *xelon.Response, error) {
+	if m.ListControlPlanesFunc == nil {
+		panic("xelonmock: KubernetesTalosAPI.ListControlPlanes called but ListControlPlanesFunc is not set")
+	}
+	return m.ListControlPlanesFunc(ctx, kubernetesClusterID)
+}
+
+// ListClusterPools calls ListClusterPoolsFunc.
+func (m *KubernetesTalosAPI) ListClusterPools(ctx context.Context, kubernetesClusterID string) ([]xelon.ClusterPool, *xelon.Response, error) {
+	if m.ListClusterPoolsFunc == nil {
+		panic("xelonmock: KubernetesTalosAPI.ListClusterPools called but ListClusterPoolsFunc is not set")
+	}
+	return m.ListClusterPoolsFunc(ctx, kubernetesClusterID)
+}
+
+// AddClusterNode calls AddClusterNodeFunc.
+func (m *KubernetesTalosAPI) AddClusterNode(ctx context.Context, kubernetesClusterID string, clusterPoolID string) (*xelon.SuccessResponse, *xelon.Response, error) {
+	if m.AddClusterNodeFunc == nil {
+		panic("xelonmock: KubernetesTalosAPI.AddClusterNode called but AddClusterNodeFunc is not set")
+	}
+	return m.AddClusterNodeFunc(ctx, kubernetesClusterID, clusterPoolID)
+}
+
+// DeleteClusterNode calls DeleteClusterNodeFunc.
+func (m *KubernetesTalosAPI) DeleteClusterNode(ctx context.Context, kubernetesClusterID string, clusterNodeID string) (*xelon.SuccessResponse, *xelon.Response, error) {
+	if m.DeleteClusterNodeFunc == nil {
+		panic("xelonmock: KubernetesTalosAPI.DeleteClusterNode called but DeleteClusterNodeFunc is not set")
+	}
+	return m.DeleteClusterNodeFunc(ctx, kubernetesClusterID, clusterNodeID)
+}
+
+// LoadBalancerClustersAPI is a mock of xelon.LoadBalancerClustersAPI.
+type LoadBalancerClustersAPI struct {
+	ListFunc                  func(ctx context.Context) ([]xelon.LoadBalancerCluster, *xelon.Response, error)
+	GetFunc                   func(ctx context.Context, loadBalancerClusterID string) (*xelon.LoadBalancerCluster, *xelon.Response, error)
+	CreateFunc                func(ctx context.Context, createRequest *xelon.LoadBalancerClusterCreateRequest) (*xelon.LoadBalancerClusterCreateResponse, *xelon.Response, error)
+	DeleteFunc                func(ctx context.Context, loadBalancerClusterID string) (*xelon.Response, error)
+	ListVirtualIPsFunc        func(ctx context.Context, loadBalancerClusterID string) ([]xelon.LoadBalancerClusterVirtualIP, *xelon.Response, error)
+	GetVirtualIPFunc          func(ctx context.Context, loadBalancerClusterID string, virtualIPID string) (*xelon.LoadBalancerClusterVirtualIP, *xelon.Response, error)
+	ListForwardingRulesFunc   func(ctx context.Context, loadBalancerClusterID string, virtualIPID string) ([]xelon.LoadBalancerClusterForwardingRule, *xelon.Response, error)
+	CreateForwardingRulesFunc func(ctx context.Context, loadBalancerClusterID string, virtualIPID string, createRequest []xelon.LoadBalancerClusterForwardingRule) ([]xelon.LoadBalancerClusterForwardingRule, *xelon.Response, error)
+	UpdateForwardingRuleFunc  func(ctx context.Context, loadBalancerClusterID string, virtualIPID string, forwardingRuleID string, updateRequest *xelon.LoadBalancerClusterForwardingRuleUpdateResponse) (*xelon.APIResponse, *xelon.Response, error)
+	DeleteForwardingRuleFunc  func(ctx context.Context, loadBalancerClusterID string, virtualIPID string, forwardingRuleID string) (*xelon.Response, error)
+	WaitForStatusFunc         func(ctx context.Context, loadBalancerClusterID string, status xelon.LoadBalancerClusterStatus, opts *xelon.WaitOptions) (*xelon.LoadBalancerCluster, error)
+}
+
+var _ xelon.LoadBalancerClustersAPI = (*LoadBalancerClustersAPI)(nil)
+
+// List calls ListFunc.
+func (m *LoadBalancerClustersAPI) List(ctx context.Context) ([]xelon.LoadBalancerCluster, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx)
+}
+
+// Get calls GetFunc.
+func (m *LoadBalancerClustersAPI) Get(ctx context.Context, loadBalancerClusterID string) (*xelon.LoadBalancerCluster, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, loadBalancerClusterID)
+}
+
+// Create calls CreateFunc.
+func (m *LoadBalancerClustersAPI) Create(ctx context.Context, createRequest *xelon.LoadBalancerClusterCreateRequest) (*xelon.LoadBalancerClusterCreateResponse, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, createRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *LoadBalancerClustersAPI) Delete(ctx context.Context, loadBalancerClusterID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, loadBalancerClusterID)
+}
+
+// ListVirtualIPs calls ListVirtualIPsFunc.
+func (m *LoadBalancerClustersAPI) ListVirtualIPs(ctx context.Context, loadBalancerClusterID string) ([]xelon.LoadBalancerClusterVirtualIP, *xelon.Response, error) {
+	if m.ListVirtualIPsFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.ListVirtualIPs called but ListVirtualIPsFunc is not set")
+	}
+	return m.ListVirtualIPsFunc(ctx, loadBalancerClusterID)
+}
+
+// GetVirtualIP calls GetVirtualIPFunc.
+func (m *LoadBalancerClustersAPI) GetVirtualIP(ctx context.Context, loadBalancerClusterID string, virtualIPID string) (*xelon.LoadBalancerClusterVirtualIP, *xelon.Response, error) {
+	if m.GetVirtualIPFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.GetVirtualIP called but GetVirtualIPFunc is not set")
+	}
+	return m.GetVirtualIPFunc(ctx, loadBalancerClusterID, virtualIPID)
+}
+
+// ListForwardingRules calls ListForwardingRulesFunc.
+func (m *LoadBalancerClustersAPI) ListForwardingRules(ctx context.Context, loadBalancerClusterID string, virtualIPID string) ([]xelon.LoadBalancerClusterForwardingRule, *xelon.Response, error) {
+	if m.ListForwardingRulesFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.ListForwardingRules called but ListForwardingRulesFunc is not set")
+	}
+	return m.ListForwardingRulesFunc(ctx, loadBalancerClusterID, virtualIPID)
+}
+
+// CreateForwardingRules calls CreateForwardingRulesFunc.
+func (m *LoadBalancerClustersAPI) CreateForwardingRules(ctx context.Context, loadBalancerClusterID string, virtualIPID string, createRequest []xelon.LoadBalancerClusterForwardingRule) ([]xelon.LoadBalancerClusterForwardingRule, *xelon.Response, error) {
+	if m.CreateForwardingRulesFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.CreateForwardingRules called but CreateForwardingRulesFunc is not set")
+	}
+	return m.CreateForwardingRulesFunc(ctx, loadBalancerClusterID, virtualIPID, createRequest)
+}
+
+// UpdateForwardingRule calls UpdateForwardingRuleFunc.
+func (m *LoadBalancerClustersAPI) UpdateForwardingRule(ctx context.Context, loadBalancerClusterID string, virtualIPID string, forwardingRuleID string, updateRequest *xelon.LoadBalancerClusterForwardingRuleUpdateResponse) (*xelon.APIResponse, *xelon.Response, error) {
+	if m.UpdateForwardingRuleFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.UpdateForwardingRule called but UpdateForwardingRuleFunc is not set")
+	}
+	return m.UpdateForwardingRuleFunc(ctx, loadBalancerClusterID, virtualIPID, forwardingRuleID, updateRequest)
+}
+
+// DeleteForwardingRule calls DeleteForwardingRuleFunc.
+func (m *LoadBalancerClustersAPI) DeleteForwardingRule(ctx context.Context, loadBalancerClusterID string, virtualIPID string, forwardingRuleID string) (*xelon.Response, error) {
+	if m.DeleteForwardingRuleFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.DeleteForwardingRule called but DeleteForwardingRuleFunc is not set")
+	}
+	return m.DeleteForwardingRuleFunc(ctx, loadBalancerClusterID, virtualIPID, forwardingRuleID)
+}
+
+// WaitForStatus calls WaitForStatusFunc.
+func (m *LoadBalancerClustersAPI) WaitForStatus(ctx context.Context, loadBalancerClusterID string, status xelon.LoadBalancerClusterStatus, opts *xelon.WaitOptions) (*xelon.LoadBalancerCluster, error) {
+	if m.WaitForStatusFunc == nil {
+		panic("xelonmock: LoadBalancerClustersAPI.WaitForStatus called but WaitForStatusFunc is not set")
+	}
+	return m.WaitForStatusFunc(ctx, loadBalancerClusterID, status, opts)
+}
+
+// LoadBalancersAPI is a mock of xelon.LoadBalancersAPI.
+type LoadBalancersAPI struct {
+	ListFunc                  func(ctx context.Context, opts *xelon.LoadBalancerListOptions) ([]xelon.LoadBalancer, *xelon.Response, error)
+	AllFunc                   func(ctx context.Context, opts *xelon.LoadBalancerListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.LoadBalancer, *xelon.Response], func() error)
+	GetFunc                   func(ctx context.Context, loadBalancerID string) (*xelon.LoadBalancer, *xelon.Response, error)
+	CreateFunc                func(ctx context.Context, createRequest *xelon.LoadBalancerCreateRequest) (*xelon.LoadBalancer, *xelon.Response, error)
+	UpdateFunc                func(ctx context.Context, loadBalancerID string, updateRequest *xelon.LoadBalancerUpdateRequest) (*xelon.LoadBalancer, *xelon.Response, error)
+	DeleteFunc                func(ctx context.Context, loadBalancerID string) (*xelon.Response, error)
+	ListAssignedDevicesFunc   func(ctx context.Context, loadBalancerID string, networkID string) ([]xelon.LoadBalancerAssignedDevice, *xelon.Response, error)
+	UpdateAssignedDevicesFunc func(ctx context.Context, loadBalancerID string, updateRequest *xelon.LoadBalancerUpdateAssignedDevicesRequest) (*xelon.Response, error)
+	CreateForwardingRuleFunc  func(ctx context.Context, loadBalancerID string, createRequest *xelon.LoadBalancerCreateForwardingRuleRequest) (*xelon.LoadBalancerForwardingRule, *xelon.Response, error)
+	UpdateForwardingRuleFunc  func(ctx context.Context, loadBalancerID string, forwardingRuleID string, updateRequest *xelon.LoadBalancerUpdateForwardingRuleRequest) (*xelon.LoadBalancerForwardingRule, *xelon.Response, error)
+	DeleteForwardingRuleFunc  func(ctx context.Context, loadBalancerID string, forwardingRuleID string) (*xelon.Response, error)
+}
+
+var _ xelon.LoadBalancersAPI = (*LoadBalancersAPI)(nil)
+
+// List calls ListFunc.
+func (m *LoadBalancersAPI) List(ctx context.Context, opts *xelon.LoadBalancerListOptions) ([]xelon.LoadBalancer, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// All calls AllFunc.
+func (m *LoadBalancersAPI) All(ctx context.Context, opts *xelon.LoadBalancerListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.LoadBalancer, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *LoadBalancersAPI) Get(ctx context.Context, loadBalancerID string) (*xelon.LoadBalancer, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, loadBalancerID)
+}
+
+// Create calls CreateFunc.
+func (m *LoadBalancersAPI) Create(ctx context.Context, createRequest *xelon.LoadBalancerCreateRequest) (*xelon.LoadBalancer, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, createRequest)
+}
+
+// Update calls UpdateFunc.
+func (m *LoadBalancersAPI) Update(ctx context.Context, loadBalancerID string, updateRequest *xelon.LoadBalancerUpdateRequest) (*xelon.LoadBalancer, *xelon.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.Update called but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, loadBalancerID, updateRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *LoadBalancersAPI) Delete(ctx context.Context, loadBalancerID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, loadBalancerID)
+}
+
+// ListAssignedDevices calls ListAssignedDevicesFunc.
+func (m *LoadBalancersAPI) ListAssignedDevices(ctx context.Context, loadBalancerID string, networkID string) ([]xelon.LoadBalancerAssignedDevice, *xelon.Response, error) {
+	if m.ListAssignedDevicesFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.ListAssignedDevices called but ListAssignedDevicesFunc is not set")
+	}
+	return m.ListAssignedDevicesFunc(ctx, loadBalancerID, networkID)
+}
+
+// UpdateAssignedDevices calls UpdateAssignedDevicesFunc.
+func (m *LoadBalancersAPI) UpdateAssignedDevices(ctx context.Context, loadBalancerID string, updateRequest *xelon.LoadBalancerUpdateAssignedDevicesRequest) (*xelon.Response, error) {
+	if m.UpdateAssignedDevicesFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.UpdateAssignedDevices called but UpdateAssignedDevicesFunc is not set")
+	}
+	return m.UpdateAssignedDevicesFunc(ctx, loadBalancerID, updateRequest)
+}
+
+// CreateForwardingRule calls CreateForwardingRuleFunc.
+func (m *LoadBalancersAPI) CreateForwardingRule(ctx context.Context, loadBalancerID string, createRequest *xelon.LoadBalancerCreateForwardingRuleRequest) (*xelon.LoadBalancerForwardingRule, *xelon.Response, error) {
+	if m.CreateForwardingRuleFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.CreateForwardingRule called but CreateForwardingRuleFunc is not set")
+	}
+	return m.CreateForwardingRuleFunc(ctx, loadBalancerID, createRequest)
+}
+
+// UpdateForwardingRule calls UpdateForwardingRuleFunc.
+func (m *LoadBalancersAPI) UpdateForwardingRule(ctx context.Context, loadBalancerID string, forwardingRuleID string, updateRequest *xelon.LoadBalancerUpdateForwardingRuleRequest) (*xelon.LoadBalancerForwardingRule, *xelon.Response, error) {
+	if m.UpdateForwardingRuleFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.UpdateForwardingRule called but UpdateForwardingRuleFunc is not set")
+	}
+	return m.UpdateForwardingRuleFunc(ctx, loadBalancerID, forwardingRuleID, updateRequest)
+}
+
+// DeleteForwardingRule calls DeleteForwardingRuleFunc.
+func (m *LoadBalancersAPI) DeleteForwardingRule(ctx context.Context, loadBalancerID string, forwardingRuleID string) (*xelon.Response, error) {
+	if m.DeleteForwardingRuleFunc == nil {
+		panic("xelonmock: LoadBalancersAPI.DeleteForwardingRule called but DeleteForwardingRuleFunc is not set")
+	}
+	return m.DeleteForwardingRuleFunc(ctx, loadBalancerID, forwardingRuleID)
+}
+
+// NetworksAPI is a mock of xelon.NetworksAPI.
+type NetworksAPI struct {
+	ListFunc           func(ctx context.Context, opts *xelon.NetworkListOptions) ([]xelon.Network, *xelon.Response, error)
+	AllFunc            func(ctx context.Context, opts *xelon.NetworkListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Network, *xelon.Response], func() error)
+	ListSharedFunc     func(ctx context.Context, cloudID string, tenantID string) ([]xelon.Network, *xelon.Response, error)
+	GetFunc            func(ctx context.Context, networkID string) (*xelon.Network, *xelon.Response, error)
+	CreateLANFunc      func(ctx context.Context, createRequest *xelon.NetworkLANCreateRequest) (*xelon.Network, *xelon.Response, error)
+	UpdateLANFunc      func(ctx context.Context, networkID string, updateRequest *xelon.NetworkLANUpdateRequest) (*xelon.Network, *xelon.Response, error)
+	CreateWANFunc      func(ctx context.Context, createRequest *xelon.NetworkWANCreateRequest) (*xelon.Network, *xelon.Response, error)
+	UpdateWANFunc      func(ctx context.Context, networkID string, updateRequest *xelon.NetworkWANUpdateRequest) (*xelon.Network, *xelon.Response, error)
+	DeleteFunc         func(ctx context.Context, networkID string) (*xelon.Response, error)
+	ShareNetworksFunc  func(ctx context.Context, shareRequest *xelon.NetworkShareRequest) (*xelon.Response, error)
+	UnshareNetworkFunc func(ctx context.Context, networkID string, tenantID string) (*xelon.Response, error)
+}
+
+var _ xelon.NetworksAPI = (*NetworksAPI)(nil)
+
+// List calls ListFunc.
+func (m *NetworksAPI) List(ctx context.Context, opts *xelon.NetworkListOptions) ([]xelon.Network, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: NetworksAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// All calls AllFunc.
+func (m *NetworksAPI) All(ctx context.Context, opts *xelon.NetworkListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Network, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: NetworksAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, opts, paginatorOpts...)
+}
+
+// ListShared calls ListSharedFunc.
+func (m *NetworksAPI) ListShared(ctx context.Context, cloudID string, tenantID string) ([]xelon.Network, *xelon.Response, error) {
+	if m.ListSharedFunc == nil {
+		panic("xelonmock: NetworksAPI.ListShared called but ListSharedFunc is not set")
+	}
+	return m.ListSharedFunc(ctx, cloudID, tenantID)
+}
+
+// Get calls GetFunc.
+func (m *NetworksAPI) Get(ctx context.Context, networkID string) (*xelon.Network, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: NetworksAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, networkID)
+}
+
+// CreateLAN calls CreateLANFunc.
+func (m *NetworksAPI) CreateLAN(ctx context.Context, createRequest *xelon.NetworkLANCreateRequest) (*xelon.Network, *xelon.Response, error) {
+	if m.CreateLANFunc == nil {
+		panic("xelonmock: NetworksAPI.CreateLAN called but CreateLANFunc is not set")
+	}
+	return m.CreateLANFunc(ctx, createRequest)
+}
+
+// UpdateLAN calls UpdateLANFunc.
+func (m *NetworksAPI) UpdateLAN(ctx context.Context, networkID string, updateRequest *xelon.NetworkLANUpdateRequest) (*xelon.Network, *xelon.Response, error) {
+	if m.UpdateLANFunc == nil {
+		panic("xelonmock: NetworksAPI.UpdateLAN called but UpdateLANFunc is not set")
+	}
+	return m.UpdateLANFunc(ctx, networkID, updateRequest)
+}
+
+// CreateWAN calls CreateWANFunc.
+func (m *NetworksAPI) CreateWAN(ctx context.Context, createRequest *xelon.NetworkWANCreateRequest) (*xelon.Network, *xelon.Response, error) {
+	if m.CreateWANFunc == nil {
+		panic("xelonmock: NetworksAPI.CreateWAN called but CreateWANFunc is not set")
+	}
+	return m.CreateWANFunc(ctx, createRequest)
+}
+
+// UpdateWAN calls UpdateWANFunc.
+func (m *NetworksAPI) UpdateWAN(ctx context.Context, networkID string, updateRequest *xelon.NetworkWANUpdateRequest) (*xelon.Network, *xelon.Response, error) {
+	if m.UpdateWANFunc == nil {
+		panic("xelonmock: NetworksAPI.UpdateWAN called but UpdateWANFunc is not set")
+	}
+	return m.UpdateWANFunc(ctx, networkID, updateRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *NetworksAPI) Delete(ctx context.Context, networkID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: NetworksAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, networkID)
+}
+
+// ShareNetworks calls ShareNetworksFunc.
+func (m *NetworksAPI) ShareNetworks(ctx context.Context, shareRequest *xelon.NetworkShareRequest) (*xelon.Response, error) {
+	if m.ShareNetworksFunc == nil {
+		panic("xelonmock: NetworksAPI.ShareNetworks called but ShareNetworksFunc is not set")
+	}
+	return m.ShareNetworksFunc(ctx, shareRequest)
+}
+
+// UnshareNetwork calls UnshareNetworkFunc.
+func (m *NetworksAPI) UnshareNetwork(ctx context.Context, networkID string, tenantID string) (*xelon.Response, error) {
+	if m.UnshareNetworkFunc == nil {
+		panic("xelonmock: NetworksAPI.UnshareNetwork called but UnshareNetworkFunc is not set")
+	}
+	return m.UnshareNetworkFunc(ctx, networkID, tenantID)
+}
+
+// ObjectStoragesAPI is a mock of xelon.ObjectStoragesAPI.
+type ObjectStoragesAPI struct {
+	ListUsersFunc                  func(ctx context.Context, opts *xelon.ObjectStorageUserListOptions) ([]xelon.ObjectStorageUser, *xelon.Response, error)
+	AllUsersFunc                   func(ctx context.Context, opts *xelon.ObjectStorageUserListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.ObjectStorageUser, *xelon.Response], func() error)
+	GetUserFunc                    func(ctx context.Context, objectStorageUserID string) (*xelon.ObjectStorageUser, *xelon.Response, error)
+	CreateUserFunc                 func(ctx context.Context, createRequest *xelon.ObjectStorageUserCreateRequest) (*xelon.ObjectStorageUser, *xelon.Response, error)
+	UpdateUserFunc                 func(ctx context.Context, objectStorageUserID string, updateRequest *xelon.ObjectStorageUserUpdateRequest) (*xelon.ObjectStorageUser, *xelon.Response, error)
+	DeleteUserFunc                 func(ctx context.Context, objectStorageUserID string) (*xelon.Response, error)
+	CreateUserTokenFunc            func(ctx context.Context, objectStorageUserID string) (*xelon.ObjectStorageUserToken, *xelon.Response, error)
+	DeleteUserTokenFunc            func(ctx context.Context, objectStorageUserID string, objectStorageUserTokenID string) (*xelon.Response, error)
+	ListBucketsFunc                func(ctx context.Context, opts *xelon.ObjectStorageBucketListOptions) ([]xelon.ObjectStorageBucket, *xelon.Response, error)
+	AllBucketsFunc                 func(ctx context.Context, opts *xelon.ObjectStorageBucketListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.ObjectStorageBucket, *xelon.Response], func() error)
+	GetBucketFunc                  func(ctx context.Context, bucketName string, objectStorageUserID string) (*xelon.ObjectStorageBucket, *xelon.Response, error)
+	CreateBucketFunc               func(ctx context.Context, createRequest *xelon.ObjectStorageBucketCreateRequest) (*xelon.ObjectStorageBucket, *xelon.Response, error)
+	UpdateBucketFunc               func(ctx context.Context, bucketID string, updateRequest *xelon.ObjectStorageBucketUpdateRequest) (*xelon.Response, error)
+	DeleteBucketFunc               func(ctx context.Context, bucketName string, objectStorageUserID string) (*xelon.Response, error)
+	UpdateBucketVersioningFunc     func(ctx context.Context, bucketName string, objectStorageUserID string, updateRequest *xelon.ObjectStorageBucketVersioningUpdateRequest) (*xelon.Response, error)
+	GetBucketIPRestrictionsFunc    func(ctx context.Context, bucketName string, objectStorageUserID string) (*xelon.ObjectStorageBucketIPRestrictions, *xelon.Response, error)
+	UpdateBucketIPRestrictionsFunc func(ctx context.Context, bucketName string, objectStorageUserID string, updateRequest *xelon.ObjectStorageBucketIPRestrictionsUpdateRequest) (*xelon.Response, error)
+	ListPlansFunc                  func(ctx context.Context) ([]xelon.ObjectStoragePlan, *xelon.Response, error)
+	ListRegionsFunc                func(ctx context.Context) ([]xelon.ObjectStorageRegion, *xelon.Response, error)
+}
+
+var _ xelon.ObjectStoragesAPI = (*ObjectStoragesAPI)(nil)
+
+// ListUsers calls ListUsersFunc.
+func (m *ObjectStoragesAPI) ListUsers(ctx context.Context, opts *xelon.ObjectStorageUserListOptions) ([]xelon.ObjectStorageUser, *xelon.Response, error) {
+	if m.ListUsersFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.ListUsers called but ListUsersFunc is not set")
+	}
+	return m.ListUsersFunc(ctx, opts)
+}
+
+// AllUsers calls AllUsersFunc.
+func (m *ObjectStoragesAPI) AllUsers(ctx context.Context, opts *xelon.ObjectStorageUserListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.ObjectStorageUser, *xelon.Response], func() error) {
+	if m.AllUsersFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.AllUsers called but AllUsersFunc is not set")
+	}
+	return m.AllUsersFunc(ctx, opts, paginatorOpts...)
+}
+
+// GetUser calls GetUserFunc.
+func (m *ObjectStoragesAPI) GetUser(ctx context.Context, objectStorageUserID string) (*xelon.ObjectStorageUser, *xelon.Response, error) {
+	if m.GetUserFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.GetUser called but GetUserFunc is not set")
+	}
+	return m.GetUserFunc(ctx, objectStorageUserID)
+}
+
+// CreateUser calls CreateUserFunc.
+func (m *ObjectStoragesAPI) CreateUser(ctx context.Context, createRequest *xelon.ObjectStorageUserCreateRequest) (*xelon.ObjectStorageUser, *xelon.Response, error) {
+	if m.CreateUserFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.CreateUser called but CreateUserFunc is not set")
+	}
+	return m.CreateUserFunc(ctx, createRequest)
+}
+
+// UpdateUser calls UpdateUserFunc.
+func (m *ObjectStoragesAPI) UpdateUser(ctx context.Context, objectStorageUserID string, updateRequest *xelon.ObjectStorageUserUpdateRequest) (*xelon.ObjectStorageUser, *xelon.Response, error) {
+	if m.UpdateUserFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.UpdateUser called but UpdateUserFunc is not set")
+	}
+	return m.UpdateUserFunc(ctx, objectStorageUserID, updateRequest)
+}
+
+// DeleteUser calls DeleteUserFunc.
+func (m *ObjectStoragesAPI) DeleteUser(ctx context.Context, objectStorageUserID string) (*xelon.Response, error) {
+	if m.DeleteUserFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.DeleteUser called but DeleteUserFunc is not set")
+	}
+	return m.DeleteUserFunc(ctx, objectStorageUserID)
+}
+
+// CreateUserToken calls CreateUserTokenFunc.
+func (m *ObjectStoragesAPI) CreateUserToken(ctx context.Context, objectStorageUserID string) (*xelon.ObjectStorageUserToken, *xelon.Response, error) {
+	if m.CreateUserTokenFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.CreateUserToken called but CreateUserTokenFunc is not set")
+	}
+	return m.CreateUserTokenFunc(ctx, objectStorageUserID)
+}
+
+// DeleteUserToken calls DeleteUserTokenFunc.
+func (m *ObjectStoragesAPI) DeleteUserToken(ctx context.Context, objectStorageUserID string, objectStorageUserTokenID string) (*xelon.Response, error) {
+	if m.DeleteUserTokenFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.DeleteUserToken called but DeleteUserTokenFunc is not set")
+	}
+	return m.DeleteUserTokenFunc(ctx, objectStorageUserID, objectStorageUserTokenID)
+}
+
+// ListBuckets calls ListBucketsFunc.
+func (m *ObjectStoragesAPI) ListBuckets(ctx context.Context, opts *xelon.ObjectStorageBucketListOptions) ([]xelon.ObjectStorageBucket, *xelon.Response, error) {
+	if m.ListBucketsFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.ListBuckets called but ListBucketsFunc is not set")
+	}
+	return m.ListBucketsFunc(ctx, opts)
+}
+
+// AllBuckets calls AllBucketsFunc.
+func (m *ObjectStoragesAPI) AllBuckets(ctx context.Context, opts *xelon.ObjectStorageBucketListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.ObjectStorageBucket, *xelon.Response], func() error) {
+	if m.AllBucketsFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.AllBuckets called but AllBucketsFunc is not set")
+	}
+	return m.AllBucketsFunc(ctx, opts, paginatorOpts...)
+}
+
+// GetBucket calls GetBucketFunc.
+func (m *ObjectStoragesAPI) GetBucket(ctx context.Context, bucketName string, objectStorageUserID string) (*xelon.ObjectStorageBucket, *xelon.Response, error) {
+	if m.GetBucketFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.GetBucket called but GetBucketFunc is not set")
+	}
+	return m.GetBucketFunc(ctx, bucketName, objectStorageUserID)
+}
+
+// CreateBucket calls CreateBucketFunc.
+func (m *ObjectStoragesAPI) CreateBucket(ctx context.Context, createRequest *xelon.ObjectStorageBucketCreateRequest) (*xelon.ObjectStorageBucket, *xelon.Response, error) {
+	if m.CreateBucketFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.CreateBucket called but CreateBucketFunc is not set")
+	}
+	return m.CreateBucketFunc(ctx, createRequest)
+}
+
+// UpdateBucket calls UpdateBucketFunc.
+func (m *ObjectStoragesAPI) UpdateBucket(ctx context.Context, bucketID string, updateRequest *xelon.ObjectStorageBucketUpdateRequest) (*xelon.Response, error) {
+	if m.UpdateBucketFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.UpdateBucket called but UpdateBucketFunc is not set")
+	}
+	return m.UpdateBucketFunc(ctx, bucketID, updateRequest)
+}
+
+// DeleteBucket calls DeleteBucketFunc.
+func (m *ObjectStoragesAPI) DeleteBucket(ctx context.Context, bucketName string, objectStorageUserID string) (*xelon.Response, error) {
+	if m.DeleteBucketFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.DeleteBucket called but DeleteBucketFunc is not set")
+	}
+	return m.DeleteBucketFunc(ctx, bucketName, objectStorageUserID)
+}
+
+// UpdateBucketVersioning calls UpdateBucketVersioningFunc.
+func (m *ObjectStoragesAPI) UpdateBucketVersioning(ctx context.Context, bucketName string, objectStorageUserID string, updateRequest *xelon.ObjectStorageBucketVersioningUpdateRequest) (*xelon.Response, error) {
+	if m.UpdateBucketVersioningFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.UpdateBucketVersioning called but UpdateBucketVersioningFunc is not set")
+	}
+	return m.UpdateBucketVersioningFunc(ctx, bucketName, objectStorageUserID, updateRequest)
+}
+
+// GetBucketIPRestrictions calls GetBucketIPRestrictionsFunc.
+func (m *ObjectStoragesAPI) GetBucketIPRestrictions(ctx context.Context, bucketName string, objectStorageUserID string) (*xelon.ObjectStorageBucketIPRestrictions, *xelon.Response, error) {
+	if m.GetBucketIPRestrictionsFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.GetBucketIPRestrictions called but GetBucketIPRestrictionsFunc is not set")
+	}
+	return m.GetBucketIPRestrictionsFunc(ctx, bucketName, objectStorageUserID)
+}
+
+// UpdateBucketIPRestrictions calls UpdateBucketIPRestrictionsFunc.
+func (m *ObjectStoragesAPI) UpdateBucketIPRestrictions(ctx context.Context, bucketName string, objectStorageUserID string, updateRequest *xelon.ObjectStorageBucketIPRestrictionsUpdateRequest) (*xelon.Response, error) {
+	if m.UpdateBucketIPRestrictionsFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.UpdateBucketIPRestrictions called but UpdateBucketIPRestrictionsFunc is not set")
+	}
+	return m.UpdateBucketIPRestrictionsFunc(ctx, bucketName, objectStorageUserID, updateRequest)
+}
+
+// ListPlans calls ListPlansFunc.
+func (m *ObjectStoragesAPI) ListPlans(ctx context.Context) ([]xelon.ObjectStoragePlan, *xelon.Response, error) {
+	if m.ListPlansFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.ListPlans called but ListPlansFunc is not set")
+	}
+	return m.ListPlansFunc(ctx)
+}
+
+// ListRegions calls ListRegionsFunc.
+func (m *ObjectStoragesAPI) ListRegions(ctx context.Context) ([]xelon.ObjectStorageRegion, *xelon.Response, error) {
+	if m.ListRegionsFunc == nil {
+		panic("xelonmock: ObjectStoragesAPI.ListRegions called but ListRegionsFunc is not set")
+	}
+	return m.ListRegionsFunc(ctx)
+}
+
+// PersistentStoragesAPI is a mock of xelon.PersistentStoragesAPI.
+type PersistentStoragesAPI struct {
+	ListFunc             func(ctx context.Context, opts *xelon.PersistentStorageListOptions) ([]xelon.PersistentStorage, *xelon.Response, error)
+	AllFunc              func(ctx context.Context, opts *xelon.PersistentStorageListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.PersistentStorage, *xelon.Response], func() error)
+	GetFunc              func(ctx context.Context, persistentStorageID string) (*xelon.PersistentStorage, *xelon.Response, error)
+	CreateFunc           func(ctx context.Context, createRequest *xelon.PersistentStorageCreateRequest) (*xelon.PersistentStorage, *xelon.Response, error)
+	DeleteFunc           func(ctx context.Context, persistentStorageID string) (*xelon.Response, error)
+	AttachToDeviceFunc   func(ctx context.Context, persistentStorageID string, deviceID string) (*xelon.Response, error)
+	DetachFromDeviceFunc func(ctx context.Context, persistentStorageID string, deviceID string) (*xelon.Response, error)
+	ExtendFunc           func(ctx context.Context, persistentStorageID string, capacity int) (*xelon.Response, error)
+}
+
+var _ xelon.PersistentStoragesAPI = (*PersistentStoragesAPI)(nil)
+
+// List calls ListFunc.
+func (m *PersistentStoragesAPI) List(ctx context.Context, opts *xelon.PersistentStorageListOptions) ([]xelon.PersistentStorage, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: PersistentStoragesAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// All calls AllFunc.
+func (m *PersistentStoragesAPI) All(ctx context.Context, opts *xelon.PersistentStorageListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.PersistentStorage, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: PersistentStoragesAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *PersistentStoragesAPI) Get(ctx context.Context, persistentStorageID string) (*xelon.PersistentStorage, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: PersistentStoragesAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, persistentStorageID)
+}
+
+// Create calls CreateFunc.
+func (m *PersistentStoragesAPI) Create(ctx context.Context, createRequest *xelon.PersistentStorageCreateRequest) (*xelon.PersistentStorage, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: PersistentStoragesAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, createRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *PersistentStoragesAPI) Delete(ctx context.Context, persistentStorageID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: PersistentStoragesAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, persistentStorageID)
+}
+
+// AttachToDevice calls AttachToDeviceFunc.
+func (m *PersistentStoragesAPI) AttachToDevice(ctx context.Context, persistentStorageID string, deviceID string) (*xelon.Response, error) {
+	if m.AttachToDeviceFunc == nil {
+		panic("xelonmock: PersistentStoragesAPI.AttachToDevice called but AttachToDeviceFunc is not set")
+	}
+	return m.AttachToDeviceFunc(ctx, persistentStorageID, deviceID)
+}
+
+// DetachFromDevice calls DetachFromDeviceFunc.
+func (m *PersistentStoragesAPI) DetachFromDevice(ctx context.Context, persistentStorageID string, deviceID string) (*xelon.Response, error) {
+	if m.DetachFromDeviceFunc == nil {
+		panic("xelonmock: PersistentStoragesAPI.DetachFromDevice called but DetachFromDeviceFunc is not set")
+	}
+	return m.DetachFromDeviceFunc(ctx, persistentStorageID, deviceID)
+}
+
+// Extend calls ExtendFunc.
+func (m *PersistentStoragesAPI) Extend(ctx context.Context, persistentStorageID string, capacity int) (*xelon.Response, error) {
+	if m.ExtendFunc == nil {
+		panic("xelonmock: PersistentStoragesAPI.Extend called but ExtendFunc is not set")
+	}
+	return m.ExtendFunc(ctx, persistentStorageID, capacity)
+}
+
+// SSHKeysAPI is a mock of xelon.SSHKeysAPI.
+type SSHKeysAPI struct {
+	ListFunc       func(ctx context.Context, opts *xelon.SSHKeyListOptions) ([]xelon.SSHKey, *xelon.Response, error)
+	AllFunc        func(ctx context.Context, opts *xelon.SSHKeyListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.SSHKey, *xelon.Response], func() error)
+	GetFunc        func(ctx context.Context, sshKeyID string) (*xelon.SSHKey, *xelon.Response, error)
+	CreateFunc     func(ctx context.Context, createRequest *xelon.SSHKeyCreateRequest) (*xelon.SSHKey, *xelon.Response, error)
+	UpdateFunc     func(ctx context.Context, sshKeyID string, updateRequest *xelon.SSHKeyUpdateRequest) (*xelon.SSHKey, *xelon.Response, error)
+	DeleteFunc     func(ctx context.Context, sshKeyID string) (*xelon.Response, error)
+	DeleteManyFunc func(ctx context.Context, sshKeyIDs []string, concurrency int) xelon.BulkResults[string]
+}
+
+var _ xelon.SSHKeysAPI = (*SSHKeysAPI)(nil)
+
+// List calls ListFunc.
+func (m *SSHKeysAPI) List(ctx context.Context, opts *xelon.SSHKeyListOptions) ([]xelon.SSHKey, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: SSHKeysAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// All calls AllFunc.
+func (m *SSHKeysAPI) All(ctx context.Context, opts *xelon.SSHKeyListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.SSHKey, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: SSHKeysAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *SSHKeysAPI) Get(ctx context.Context, sshKeyID string) (*xelon.SSHKey, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: SSHKeysAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, sshKeyID)
+}
+
+// Create calls CreateFunc.
+func (m *SSHKeysAPI) Create(ctx context.Context, createRequest *xelon.SSHKeyCreateRequest) (*xelon.SSHKey, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: SSHKeysAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, createRequest)
+}
+
+// Update calls UpdateFunc.
+func (m *SSHKeysAPI) Update(ctx context.Context, sshKeyID string, updateRequest *xelon.SSHKeyUpdateRequest) (*xelon.SSHKey, *xelon.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("xelonmock: SSHKeysAPI.Update called but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, sshKeyID, updateRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *SSHKeysAPI) Delete(ctx context.Context, sshKeyID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: SSHKeysAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, sshKeyID)
+}
+
+// DeleteMany calls DeleteManyFunc.
+func (m *SSHKeysAPI) DeleteMany(ctx context.Context, sshKeyIDs []string, concurrency int) xelon.BulkResults[string] {
+	if m.DeleteManyFunc == nil {
+		panic("xelonmock: SSHKeysAPI.DeleteMany called but DeleteManyFunc is not set")
+	}
+	return m.DeleteManyFunc(ctx, sshKeyIDs, concurrency)
+}
+
+// SnapshotsAPI is a mock of xelon.SnapshotsAPI.
+type SnapshotsAPI struct {
+	ListFunc            func(ctx context.Context, deviceID string, opts *xelon.SnapshotListOptions) ([]xelon.Snapshot, *xelon.Response, error)
+	AllFunc             func(ctx context.Context, deviceID string, opts *xelon.SnapshotListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Snapshot, *xelon.Response], func() error)
+	GetFunc             func(ctx context.Context, deviceID string, snapshotID string) (*xelon.Snapshot, *xelon.Response, error)
+	CreateFunc          func(ctx context.Context, deviceID string, createRequest *xelon.SnapshotCreateRequest) (*xelon.Snapshot, *xelon.Response, error)
+	UpdateFunc          func(ctx context.Context, deviceID string, snapshotID string, updateRequest *xelon.SnapshotUpdateRequest) (*xelon.Snapshot, *xelon.Response, error)
+	DeleteFunc          func(ctx context.Context, deviceID string, snapshotID string, deleteRequest *xelon.SnapshotDeleteRequest) (*xelon.Response, error)
+	RevertFunc          func(ctx context.Context, deviceID string, snapshotID string) (*xelon.Response, error)
+	TreeFunc            func(ctx context.Context, deviceID string) (*xelon.SnapshotTree, error)
+	WaitForStatusFunc   func(ctx context.Context, deviceID string, snapshotID string, status xelon.SnapshotStatus, opts *xelon.WaitOptions) (*xelon.Snapshot, error)
+	WaitForDeletionFunc func(ctx context.Context, deviceID string, snapshotID string, opts *xelon.WaitOptions) error
+	WaitForRevertFunc   func(ctx context.Context, deviceID string, snapshotID string, opts *xelon.WaitOptions) (*xelon.Snapshot, error)
+}
+
+var _ xelon.SnapshotsAPI = (*SnapshotsAPI)(nil)
+
+// List calls ListFunc.
+func (m *SnapshotsAPI) List(ctx context.Context, deviceID string, opts *xelon.SnapshotListOptions) ([]xelon.Snapshot, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: SnapshotsAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, deviceID, opts)
+}
+
+// All calls AllFunc.
+func (m *SnapshotsAPI) All(ctx context.Context, deviceID string, opts *xelon.SnapshotListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Snapshot, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: SnapshotsAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, deviceID, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *SnapshotsAPI) Get(ctx context.Context, deviceID string, snapshotID string) (*xelon.Snapshot, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: SnapshotsAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, deviceID, snapshotID)
+}
+
+// Create calls CreateFunc.
+func (m *SnapshotsAPI) Create(ctx context.Context, deviceID string, createRequest *xelon.SnapshotCreateRequest) (*xelon.Snapshot, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: SnapshotsAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, deviceID, createRequest)
+}
+
+// Update calls UpdateFunc.
+func (m *SnapshotsAPI) Update(ctx context.Context, deviceID string, snapshotID string, updateRequest *xelon.SnapshotUpdateRequest) (*xelon.Snapshot, *xelon.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("xelonmock: SnapshotsAPI.Update called but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, deviceID, snapshotID, updateRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *SnapshotsAPI) Delete(ctx context.Context, deviceID string, snapshotID string, deleteRequest *xelon.SnapshotDeleteRequest) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: SnapshotsAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, deviceID, snapshotID, deleteRequest)
+}
+
+// Revert calls RevertFunc.
+func (m *SnapshotsAPI) Revert(ctx context.Context, deviceID string, snapshotID string) (*xelon.Response, error) {
+	if m.RevertFunc == nil {
+		panic("xelonmock: SnapshotsAPI.Revert called but RevertFunc is not set")
+	}
+	return m.RevertFunc(ctx, deviceID, snapshotID)
+}
+
+// Tree calls TreeFunc.
+func (m *SnapshotsAPI) Tree(ctx context.Context, deviceID string) (*xelon.SnapshotTree, error) {
+	if m.TreeFunc == nil {
+		panic("xelonmock: SnapshotsAPI.Tree called but TreeFunc is not set")
+	}
+	return m.TreeFunc(ctx, deviceID)
+}
+
+// WaitForStatus calls WaitForStatusFunc.
+func (m *SnapshotsAPI) WaitForStatus(ctx context.Context, deviceID string, snapshotID string, status xelon.SnapshotStatus, opts *xelon.WaitOptions) (*xelon.Snapshot, error) {
+	if m.WaitForStatusFunc == nil {
+		panic("xelonmock: SnapshotsAPI.WaitForStatus called but WaitForStatusFunc is not set")
+	}
+	return m.WaitForStatusFunc(ctx, deviceID, snapshotID, status, opts)
+}
+
+// WaitForDeletion calls WaitForDeletionFunc.
+func (m *SnapshotsAPI) WaitForDeletion(ctx context.Context, deviceID string, snapshotID string, opts *xelon.WaitOptions) error {
+	if m.WaitForDeletionFunc == nil {
+		panic("xelonmock: SnapshotsAPI.WaitForDeletion called but WaitForDeletionFunc is not set")
+	}
+	return m.WaitForDeletionFunc(ctx, deviceID, snapshotID, opts)
+}
+
+// WaitForRevert calls WaitForRevertFunc.
+func (m *SnapshotsAPI) WaitForRevert(ctx context.Context, deviceID string, snapshotID string, opts *xelon.WaitOptions) (*xelon.Snapshot, error) {
+	if m.WaitForRevertFunc == nil {
+		panic("xelonmock: SnapshotsAPI.WaitForRevert called but WaitForRevertFunc is not set")
+	}
+	return m.WaitForRevertFunc(ctx, deviceID, snapshotID, opts)
+}
+
+// TemplatesAPI is a mock of xelon.TemplatesAPI.
+type TemplatesAPI struct {
+	ListFunc          func(ctx context.Context, opts *xelon.TemplateListOptions) ([]xelon.Template, *xelon.Response, error)
+	AllFunc           func(ctx context.Context, opts *xelon.TemplateListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Template, *xelon.Response], func() error)
+	GetFunc           func(ctx context.Context, templateID string) (*xelon.Template, *xelon.Response, error)
+	CreateFunc        func(ctx context.Context, createRequest *xelon.TemplateCreateRequest) (*xelon.Template, *xelon.Response, error)
+	UpdateFunc        func(ctx context.Context, templateID string, updateRequest *xelon.TemplateUpdateRequest) (*xelon.Template, *xelon.Response, error)
+	DeleteFunc        func(ctx context.Context, templateID string) (*xelon.Response, error)
+	WaitForStatusFunc func(ctx context.Context, templateID string, status int, opts *xelon.WaitOptions) (*xelon.Template, error)
+}
+
+var _ xelon.TemplatesAPI = (*TemplatesAPI)(nil)
+
+// List calls ListFunc.
+func (m *TemplatesAPI) List(ctx context.Context, opts *xelon.TemplateListOptions) ([]xelon.Template, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: TemplatesAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// All calls AllFunc.
+func (m *TemplatesAPI) All(ctx context.Context, opts *xelon.TemplateListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Template, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: TemplatesAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *TemplatesAPI) Get(ctx context.Context, templateID string) (*xelon.Template, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: TemplatesAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, templateID)
+}
+
+// Create calls CreateFunc.
+func (m *TemplatesAPI) Create(ctx context.Context, createRequest *xelon.TemplateCreateRequest) (*xelon.Template, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: TemplatesAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, createRequest)
+}
+
+// Update calls UpdateFunc.
+func (m *TemplatesAPI) Update(ctx context.Context, templateID string, updateRequest *xelon.TemplateUpdateRequest) (*xelon.Template, *xelon.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("xelonmock: TemplatesAPI.Update called but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, templateID, updateRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *TemplatesAPI) Delete(ctx context.Context, templateID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: TemplatesAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, templateID)
+}
+
+// WaitForStatus calls WaitForStatusFunc.
+func (m *TemplatesAPI) WaitForStatus(ctx context.Context, templateID string, status int, opts *xelon.WaitOptions) (*xelon.Template, error) {
+	if m.WaitForStatusFunc == nil {
+		panic("xelonmock: TemplatesAPI.WaitForStatus called but WaitForStatusFunc is not set")
+	}
+	return m.WaitForStatusFunc(ctx, templateID, status, opts)
+}
+
+// TenantUsersAPI is a mock of xelon.TenantUsersAPI.
+type TenantUsersAPI struct {
+	ListFunc                     func(ctx context.Context, tenantID string, opts *xelon.TenantUserListOptions) ([]xelon.TenantUser, *xelon.Response, error)
+	AllFunc                      func(ctx context.Context, tenantID string, opts *xelon.TenantUserListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.TenantUser, *xelon.Response], func() error)
+	GetFunc                      func(ctx context.Context, tenantID string, userID string) (*xelon.TenantUserWithDetails, *xelon.Response, error)
+	CreateFunc                   func(ctx context.Context, tenantID string, createRequest *xelon.TenantUserCreateRequest) (*xelon.TenantUser, *xelon.Response, error)
+	UpdateFunc                   func(ctx context.Context, tenantID string, userID string, updateRequest *xelon.TenantUserUpdateRequest) (*xelon.TenantUser, *xelon.Response, error)
+	DeleteFunc                   func(ctx context.Context, tenantID string, userID string) (*xelon.Response, error)
+	RestoreFunc                  func(ctx context.Context, tenantID string, userID string) (*xelon.Response, error)
+	UpdatePasswordFunc           func(ctx context.Context, tenantID string, userID string, updateRequest *xelon.TenantUserPasswordUpdateRequest) (*xelon.Response, error)
+	ListAvailablePermissionsFunc func(ctx context.Context, tenantID string) ([]xelon.TenantUserPermission, *xelon.Response, error)
+	UpdatePermissionsFunc        func(ctx context.Context, tenantID string, userID string, updateRequest *xelon.TenantUserPermissionsUpdateRequest) (*xelon.Response, error)
+}
+
+var _ xelon.TenantUsersAPI = (*TenantUsersAPI)(nil)
+
+// List calls ListFunc.
+func (m *TenantUsersAPI) List(ctx context.Context, tenantID string, opts *xelon.TenantUserListOptions) ([]xelon.TenantUser, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: TenantUsersAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, tenantID, opts)
+}
+
+// All calls AllFunc.
+func (m *TenantUsersAPI) All(ctx context.Context, tenantID string, opts *xelon.TenantUserListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.TenantUser, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: TenantUsersAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, tenantID, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *TenantUsersAPI) Get(ctx context.Context, tenantID string, userID string) (*xelon.TenantUserWithDetails, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: TenantUsersAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, tenantID, userID)
+}
+
+// Create calls CreateFunc.
+func (m *TenantUsersAPI) Create(ctx context.Context, tenantID string, createRequest *xelon.TenantUserCreateRequest) (*xelon.TenantUser, *xelon.Response, error) {
+	if m.CreateFunc == nil {
+		panic("xelonmock: TenantUsersAPI.Create called but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, tenantID, createRequest)
+}
+
+// Update calls UpdateFunc.
+func (m *TenantUsersAPI) Update(ctx context.Context, tenantID string, userID string, updateRequest *xelon.TenantUserUpdateRequest) (*xelon.TenantUser, *xelon.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("xelonmock: TenantUsersAPI.Update called but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, tenantID, userID, updateRequest)
+}
+
+// Delete calls DeleteFunc.
+func (m *TenantUsersAPI) Delete(ctx context.Context, tenantID string, userID string) (*xelon.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("xelonmock: TenantUsersAPI.Delete called but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, tenantID, userID)
+}
+
+// Restore calls RestoreFunc.
+func (m *TenantUsersAPI) Restore(ctx context.Context, tenantID string, userID string) (*xelon.Response, error) {
+	if m.RestoreFunc == nil {
+		panic("xelonmock: TenantUsersAPI.Restore called but RestoreFunc is not set")
+	}
+	return m.RestoreFunc(ctx, tenantID, userID)
+}
+
+// UpdatePassword calls UpdatePasswordFunc.
+func (m *TenantUsersAPI) UpdatePassword(ctx context.Context, tenantID string, userID string, updateRequest *xelon.TenantUserPasswordUpdateRequest) (*xelon.Response, error) {
+	if m.UpdatePasswordFunc == nil {
+		panic("xelonmock: TenantUsersAPI.UpdatePassword called but UpdatePasswordFunc is not set")
+	}
+	return m.UpdatePasswordFunc(ctx, tenantID, userID, updateRequest)
+}
+
+// ListAvailablePermissions calls ListAvailablePermissionsFunc.
+func (m *TenantUsersAPI) ListAvailablePermissions(ctx context.Context, tenantID string) ([]xelon.TenantUserPermission, *xelon.Response, error) {
+	if m.ListAvailablePermissionsFunc == nil {
+		panic("xelonmock: TenantUsersAPI.ListAvailablePermissions called but ListAvailablePermissionsFunc is not set")
+	}
+	return m.ListAvailablePermissionsFunc(ctx, tenantID)
+}
+
+// UpdatePermissions calls UpdatePermissionsFunc.
+func (m *TenantUsersAPI) UpdatePermissions(ctx context.Context, tenantID string, userID string, updateRequest *xelon.TenantUserPermissionsUpdateRequest) (*xelon.Response, error) {
+	if m.UpdatePermissionsFunc == nil {
+		panic("xelonmock: TenantUsersAPI.UpdatePermissions called but UpdatePermissionsFunc is not set")
+	}
+	return m.UpdatePermissionsFunc(ctx, tenantID, userID, updateRequest)
+}
+
+// TenantsAPI is a mock of xelon.TenantsAPI.
+type TenantsAPI struct {
+	GetCurrentFunc func(ctx context.Context) (*xelon.Tenant, *xelon.Response, error)
+	ListFunc       func(ctx context.Context, opts *xelon.TenantListOptions) ([]xelon.Tenant, *xelon.Response, error)
+	AllFunc        func(ctx context.Context, opts *xelon.TenantListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Tenant, *xelon.Response], func() error)
+	GetFunc        func(ctx context.Context, tenantID string) (*xelon.Tenant, *xelon.Response, error)
+}
+
+var _ xelon.TenantsAPI = (*TenantsAPI)(nil)
+
+// GetCurrent calls GetCurrentFunc.
+func (m *TenantsAPI) GetCurrent(ctx context.Context) (*xelon.Tenant, *xelon.Response, error) {
+	if m.GetCurrentFunc == nil {
+		panic("xelonmock: TenantsAPI.GetCurrent called but GetCurrentFunc is not set")
+	}
+	return m.GetCurrentFunc(ctx)
+}
+
+// List calls ListFunc.
+func (m *TenantsAPI) List(ctx context.Context, opts *xelon.TenantListOptions) ([]xelon.Tenant, *xelon.Response, error) {
+	if m.ListFunc == nil {
+		panic("xelonmock: TenantsAPI.List called but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// All calls AllFunc.
+func (m *TenantsAPI) All(ctx context.Context, opts *xelon.TenantListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Tenant, *xelon.Response], func() error) {
+	if m.AllFunc == nil {
+		panic("xelonmock: TenantsAPI.All called but AllFunc is not set")
+	}
+	return m.AllFunc(ctx, opts, paginatorOpts...)
+}
+
+// Get calls GetFunc.
+func (m *TenantsAPI) Get(ctx context.Context, tenantID string) (*xelon.Tenant, *xelon.Response, error) {
+	if m.GetFunc == nil {
+		panic("xelonmock: TenantsAPI.Get called but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, tenantID)
+}
@@ -0,0 +1,277 @@
+// Package xelonrecorder provides an http.RoundTripper which records interactions with
+// the Xelon API into cassettes on disk and replays them offline, e.g. in CI:
+//
+//	recorder, err := xelonrecorder.New("testdata/devices.yaml", xelonrecorder.ModeReplay)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	client := xelon.NewClient(token, xelon.WithHTTPClient(recorder.Client()))
+//
+// Before an interaction is written, the Authorization, Cookie and X-User-Id headers
+// as well as secrets like passwords, tokens and secret keys in URLs and JSON bodies
+// are scrubbed. YAML bodies like kubeconfigs are scrubbed the same way, including their
+// client keys, so they can be replayed. Other bodies are replaced completely.
+package xelonrecorder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon/internal/redact"
+)
+
+// ErrNoInteraction is returned in replay mode if no recorded interaction matches a request.
+var ErrNoInteraction = errors.New("xelonrecorder: no matching interaction")
+
+// Mode specifies how Recorder handles requests.
+type Mode int
+
+const (
+	// ModeReplay answers requests with recorded interactions without network access.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the API and records the interactions, replacing
+	// an existing cassette.
+	ModeRecord
+
+	// ModePassthrough sends requests to the API without recording them.
+	ModePassthrough
+)
+
+var modeNames = map[Mode]string{
+	ModeReplay:      "replay",
+	ModeRecord:      "record",
+	ModePassthrough: "passthrough",
+}
+
+func (m Mode) String() string {
+	if name, ok := modeNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// ParseMode returns the mode named s ("replay", "record" or "passthrough"), e.g. to
+// select the mode with an environment variable. An empty s selects ModeReplay.
+func ParseMode(s string) (Mode, error) {
+	if s == "" {
+		return ModeReplay, nil
+	}
+	for mode, name := range modeNames {
+		if strings.EqualFold(s, name) {
+			return mode, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown mode %q", s)
+}
+
+// Recorder is an http.RoundTripper recording and replaying interactions.
+// It is safe for concurrent use.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	scrubbers []func(interaction *Interaction)
+
+	mu       sync.Mutex
+	cassette *Cassette
+	replayed []bool // replayed interactions, a recorded interaction is replayed only once
+}
+
+// Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithTransport configures Recorder to send requests with transport in record and
+// passthrough mode, http.DefaultTransport by default.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(recorder *Recorder) {
+		recorder.transport = transport
+	}
+}
+
+// WithScrubber configures Recorder to call scrub for every interaction before it is
+// written, after the built-in scrubbing, e.g. to remove additional secrets.
+func WithScrubber(scrub func(interaction *Interaction)) Option {
+	return func(recorder *Recorder) {
+		recorder.scrubbers = append(recorder.scrubbers, scrub)
+	}
+}
+
+// New returns a Recorder using the cassette at path, which is stored as YAML if
+// path has a ".yaml" or ".yml" extension and as JSON otherwise. In replay mode,
+// the cassette must exist.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		cassette:  &Cassette{Version: cassetteVersion},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	switch mode {
+	case ModeReplay:
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = cassette
+		r.replayed = make([]bool, len(cassette.Interactions))
+	case ModeRecord, ModePassthrough:
+	default:
+		return nil, fmt.Errorf("unknown mode %v", mode)
+	}
+	return r, nil
+}
+
+// Client returns an http.Client using the recorder as transport, which can be
+// passed to xelon.WithHTTPClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch r.mode {
+	case ModePassthrough:
+		return r.transport.RoundTrip(req)
+	case ModeRecord:
+		return r.record(req)
+	default:
+		return r.replay(req)
+	}
+}
+
+// record sends req and appends the scrubbed interaction to the cassette on disk.
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if requestBody != nil {
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := readBody(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    redact.URL(cloneURL(req.URL)).String(),
+			Header: redact.Header(req.Header),
+			Body:   string(redact.Body(requestBody)),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     redact.Header(resp.Header),
+			Body:       string(redact.Body(responseBody)),
+		},
+	}
+	for _, scrub := range r.scrubbers {
+		scrub(&interaction)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	if err := r.cassette.Save(r.path); err != nil {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("failed to save cassette: %w", err)
+	}
+	return resp, nil
+}
+
+// replay answers req with the first matching interaction which was not replayed yet.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	body = redact.Body(body)
+	query := redact.URL(cloneURL(req.URL)).Query()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !matches(interaction.Request, req.Method, req.URL.Path, query, body) {
+			continue
+		}
+		r.replayed[i] = true
+
+		recorded := interaction.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %v", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	message := fmt.Sprintf("%v %v", req.Method, redact.URL(cloneURL(req.URL)).RequestURI())
+	if len(body) > 0 {
+		message += fmt.Sprintf(" with body %s", body)
+	}
+	return nil, fmt.Errorf("%w for %v in %v", ErrNoInteraction, message, r.path)
+}
+
+// matches reports whether the recorded request matches method, path, query and the
+// scrubbed body. JSON bodies are compared after normalization, so the order of
+// fields does not matter.
+func matches(recorded Request, method, path string, query url.Values, body []byte) bool {
+	if recorded.Method != method {
+		return false
+	}
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil || recordedURL.Path != path {
+		return false
+	}
+	if recordedQuery := recordedURL.Query(); (len(recordedQuery) != 0 || len(query) != 0) && !reflect.DeepEqual(recordedQuery, query) {
+		return false
+	}
+	return bytes.Equal(redact.Body([]byte(recorded.Body)), body)
+}
+
+// readBody reads and closes body, which may be nil.
+func readBody(body io.ReadCloser) ([]byte, error) {
+	if body == nil || body == http.NoBody {
+		return nil, nil
+	}
+	defer func() { _ = body.Close() }()
+	return io.ReadAll(body)
+}
+
+func cloneURL(u *url.URL) *url.URL {
+	clone := *u
+	return &clone
+}
@@ -0,0 +1,226 @@
+package xelonrecorder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func newAPIServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"data":[{"identifier":"key-1","name":"laptop"}],"meta":{"page":1,"lastPage":1,"perPage":10,"total":1}}`)
+	})
+	mux.HandleFunc("POST /object-storages/users/user-1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"data":{"identifier":"token-1","accessKey":"AKIA","secretKey":"s3cr3t"}}`)
+	})
+	mux.HandleFunc("GET /kubernetes/kc-1/config/kube", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = io.WriteString(w, kubeconfig)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+const kubeconfig = `apiVersion: v1
+clusters:
+  - cluster:
+      server: https://k8s.example.com:6443
+    name: production
+users:
+  - name: admin
+    user:
+      client-key-data: c2VjcmV0LWtleQ==
+`
+
+func newXelonClient(recorder *Recorder, baseURL string) *xelon.Client {
+	return xelon.NewClient("super-secret-token",
+		xelon.WithBaseURL(baseURL+"/"),
+		xelon.WithClientID("client-id"),
+		xelon.WithHTTPClient(recorder.Client()),
+	)
+}
+
+func TestParseMode(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected Mode
+	}{
+		"empty":       {input: "", expected: ModeReplay},
+		"replay":      {input: "replay", expected: ModeReplay},
+		"record":      {input: "RECORD", expected: ModeRecord},
+		"passthrough": {input: "passthrough", expected: ModePassthrough},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mode, err := ParseMode(test.input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, mode)
+		})
+	}
+
+	_, err := ParseMode("rewind")
+	assert.EqualError(t, err, `unknown mode "rewind"`)
+}
+
+func TestRecorder_recordAndReplay(t *testing.T) {
+	for _, ext := range []string{".json", ".yaml"} {
+		t.Run(ext, func(t *testing.T) {
+			server := newAPIServer(t)
+			path := filepath.Join(t.TempDir(), "cassettes", "object-storages"+ext)
+
+			recorder, err := New(path, ModeRecord)
+			require.NoError(t, err)
+			client := newXelonClient(recorder, server.URL)
+
+			token, _, err := client.ObjectStorages.CreateUserToken(context.Background(), "user-1")
+			require.NoError(t, err)
+			assert.Equal(t, "s3cr3t", token.SecretKey, "caller gets the unscrubbed response")
+			_, _, err = client.SSHKeys.List(context.Background(), &xelon.SSHKeyListOptions{Search: "laptop"})
+			require.NoError(t, err)
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.NotContains(t, string(data), "super-secret-token")
+			assert.NotContains(t, string(data), "client-id")
+			assert.NotContains(t, string(data), "s3cr3t")
+			assert.Contains(t, string(data), "REDACTED")
+
+			// replay without the server
+			server.Close()
+			recorder, err = New(path, ModeReplay)
+			require.NoError(t, err)
+			client = newXelonClient(recorder, server.URL)
+
+			token, _, err = client.ObjectStorages.CreateUserToken(context.Background(), "user-1")
+			require.NoError(t, err)
+			assert.Equal(t, "token-1", token.ID)
+			assert.Equal(t, "REDACTED", token.SecretKey)
+			sshKeys, resp, err := client.SSHKeys.List(context.Background(), &xelon.SSHKeyListOptions{Search: "laptop"})
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			if assert.Len(t, sshKeys, 1) {
+				assert.Equal(t, "laptop", sshKeys[0].Name)
+			}
+		})
+	}
+}
+
+func TestRecorder_recordAndReplayKubeconfig(t *testing.T) {
+	server := newAPIServer(t)
+	path := filepath.Join(t.TempDir(), "kubernetes.yaml")
+
+	recorder, err := New(path, ModeRecord)
+	require.NoError(t, err)
+	config, _, err := newXelonClient(recorder, server.URL).Kubernetes.GetKubeconfig(context.Background(), "kc-1")
+	require.NoError(t, err)
+	assert.Equal(t, kubeconfig, string(config), "caller gets the unscrubbed response")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "c2VjcmV0LWtleQ==")
+
+	server.Close()
+	recorder, err = New(path, ModeReplay)
+	require.NoError(t, err)
+
+	config, _, err = newXelonClient(recorder, server.URL).Kubernetes.GetKubeconfig(context.Background(), "kc-1")
+	require.NoError(t, err)
+	assert.Equal(t, strings.Replace(kubeconfig, "c2VjcmV0LWtleQ==", "REDACTED", 1), string(config))
+}
+
+func TestRecorder_replayUnmatched(t *testing.T) {
+	server := newAPIServer(t)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := New(path, ModeRecord)
+	require.NoError(t, err)
+	_, _, err = newXelonClient(recorder, server.URL).SSHKeys.List(context.Background(), &xelon.SSHKeyListOptions{Search: "laptop"})
+	require.NoError(t, err)
+
+	recorder, err = New(path, ModeReplay)
+	require.NoError(t, err)
+	client := newXelonClient(recorder, server.URL)
+
+	_, _, err = client.SSHKeys.List(context.Background(), &xelon.SSHKeyListOptions{Search: "desktop"})
+	assert.ErrorIs(t, err, ErrNoInteraction)
+	assert.ErrorContains(t, err, "GET /ssh-keys?")
+
+	_, _, err = client.SSHKeys.List(context.Background(), &xelon.SSHKeyListOptions{Search: "laptop"})
+	assert.NoError(t, err)
+
+	// every interaction is replayed only once
+	_, _, err = client.SSHKeys.List(context.Background(), &xelon.SSHKeyListOptions{Search: "laptop"})
+	assert.ErrorIs(t, err, ErrNoInteraction)
+}
+
+func TestRecorder_replayNormalizedBody(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	cassette := &Cassette{
+		Version: cassetteVersion,
+		Interactions: []Interaction{{
+			Request: Request{
+				Method: http.MethodPost,
+				URL:    "https://hq.xelon.ch/api/service/tenants/users?b=2&a=1",
+				Body:   `{"name":"john","password":"REDACTED","email":"john@example.com"}`,
+			},
+			Response: Response{StatusCode: http.StatusCreated, Body: `{"message":"created"}`},
+		}},
+	}
+	require.NoError(t, cassette.Save(path))
+
+	recorder, err := New(path, ModeReplay)
+	require.NoError(t, err)
+
+	body := `{"email": "john@example.com", "name": "john", "password": "different-secret"}`
+	req, err := http.NewRequest(http.MethodPost, "https://hq.xelon.ch/api/service/tenants/users?a=1&b=2", strings.NewReader(body))
+	require.NoError(t, err)
+	resp, err := recorder.RoundTrip(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	var message struct{ Message string }
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&message))
+	assert.Equal(t, "created", message.Message)
+}
+
+func TestRecorder_passthrough(t *testing.T) {
+	server := newAPIServer(t)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := New(path, ModePassthrough)
+	require.NoError(t, err)
+	sshKeys, _, err := newXelonClient(recorder, server.URL).SSHKeys.List(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.Len(t, sshKeys, 1)
+	_, err = os.Stat(path)
+	assert.True(t, errors.Is(err, os.ErrNotExist), "passthrough must not write a cassette")
+}
+
+func TestNew_replayMissingCassette(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
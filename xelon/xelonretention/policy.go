@@ -0,0 +1,139 @@
+package xelonretention
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+// Policy specifies which snapshots of a device are retained. A snapshot is deleted if
+// it is subject to the policy and exceeds KeepLast or MaxAge.
+//
+// The current snapshot of a device and snapshots without creation time are never deleted.
+type Policy struct {
+	// KeepLast is the number of newest snapshots retained, 0 disables the limit.
+	KeepLast int
+
+	// MaxAge is the age after which snapshots are deleted even if they are among
+	// the newest KeepLast, 0 disables the limit.
+	MaxAge time.Duration
+
+	// NamePattern restricts the policy to snapshots with a matching name, e.g. the
+	// ones taken by an automation. Other snapshots are retained and not counted.
+	NamePattern *regexp.Regexp
+
+	// KeepTag marks snapshots to retain, e.g. "#keep". Snapshots whose name or
+	// description contains the tag are retained and not counted.
+	KeepTag string
+}
+
+// Validate reports whether the policy limits the number or the age of snapshots.
+func (p Policy) Validate() error {
+	if p.KeepLast < 0 {
+		return errors.New("keep last must not be negative")
+	}
+	if p.MaxAge < 0 {
+		return errors.New("max age must not be negative")
+	}
+	if p.KeepLast == 0 && p.MaxAge == 0 {
+		return errors.New("keep last or max age must be set")
+	}
+	return nil
+}
+
+// Evaluate returns the snapshots in tree of the device identified by id the policy
+// deletes at now, ordered such that children are deleted before their parents.
+func (p Policy) Evaluate(deviceID string, tree *xelon.SnapshotTree, now time.Time) []Deletion {
+	// snapshots subject to the policy, newest first
+	var subject []xelon.Snapshot
+	for node := range tree.Walk() {
+		snapshot := node.Snapshot
+		if snapshot.Current || snapshot.CreatedAt == nil || p.tagged(snapshot) {
+			continue
+		}
+		if p.NamePattern != nil && !p.NamePattern.MatchString(snapshot.Name) {
+			continue
+		}
+		subject = append(subject, snapshot)
+	}
+	slices.SortStableFunc(subject, func(a, b xelon.Snapshot) int {
+		return b.CreatedAt.Compare(*a.CreatedAt)
+	})
+
+	reasons := make(map[string]string)
+	for i, snapshot := range subject {
+		switch age := now.Sub(*snapshot.CreatedAt); {
+		case p.MaxAge > 0 && age > p.MaxAge:
+			reasons[snapshot.ID] = fmt.Sprintf("older than %v", p.MaxAge)
+		case p.KeepLast > 0 && i >= p.KeepLast:
+			reasons[snapshot.ID] = fmt.Sprintf("exceeds the newest %d", p.KeepLast)
+		}
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	// children before parents, so no deletion changes a snapshot deleted later
+	var deletions []Deletion
+	for node := range tree.Walk() {
+		if reason, ok := reasons[node.Snapshot.ID]; ok {
+			deletions = append(deletions, Deletion{DeviceID: deviceID, Snapshot: node.Snapshot, Reason: reason})
+		}
+	}
+	slices.Reverse(deletions)
+	return deletions
+}
+
+// tagged reports whether snapshot is marked with KeepTag.
+func (p Policy) tagged(snapshot xelon.Snapshot) bool {
+	return p.KeepTag != "" && (strings.Contains(snapshot.Name, p.KeepTag) || strings.Contains(snapshot.Description, p.KeepTag))
+}
+
+// Deletion is a snapshot deleted by a Policy.
+type Deletion struct {
+	DeviceID string         `json:"deviceId"`
+	Snapshot xelon.Snapshot `json:"snapshot"`
+	Reason   string         `json:"reason"`
+}
+
+// Plan is the dry-run result of Enforcer.Evaluate, the deletions of all devices.
+type Plan struct {
+	Deletions []Deletion `json:"deletions"`
+}
+
+// String renders the deletions one per line, followed by a summary.
+func (p *Plan) String() string {
+	if len(p.Deletions) == 0 {
+		return "No snapshots to delete.\n"
+	}
+
+	var b strings.Builder
+	devices := make(map[string]bool)
+	for _, deletion := range p.Deletions {
+		devices[deletion.DeviceID] = true
+		fmt.Fprintf(&b, "- %v/%v %q (%v)\n", deletion.DeviceID, deletion.Snapshot.ID, deletion.Snapshot.Name, deletion.Reason)
+	}
+	fmt.Fprintf(&b, "\nPlan: %d snapshots of %d devices to delete.\n", len(p.Deletions), len(devices))
+	return b.String()
+}
+
+// devices returns the deletions grouped by device in the order of the plan.
+func (p *Plan) devices() [][]Deletion {
+	var groups [][]Deletion
+	for _, deletion := range p.Deletions {
+		i := slices.IndexFunc(groups, func(group []Deletion) bool {
+			return group[0].DeviceID == deletion.DeviceID
+		})
+		if i < 0 {
+			groups = append(groups, nil)
+			i = len(groups) - 1
+		}
+		groups[i] = append(groups[i], deletion)
+	}
+	return groups
+}
@@ -0,0 +1,182 @@
+// Package xelonretention enforces snapshot retention policies across all devices of a
+// tenant, e.g. "keep the last 7 snapshots and nothing older than 30 days":
+//
+//	enforcer, err := xelonretention.New(client.Devices, client.Snapshots, xelonretention.Policy{
+//		KeepLast: 7,
+//		MaxAge:   30 * 24 * time.Hour,
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	plan, err := enforcer.Evaluate(ctx, tenantID)
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Print(plan) // dry run
+//	err = enforcer.Execute(ctx, plan).Err()
+package xelonretention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+// ErrSkipped is the error of a deletion which was not attempted because an earlier
+// deletion of the same device failed.
+var ErrSkipped = errors.New("skipped after failed deletion")
+
+// Option configures an Enforcer.
+type Option func(*Enforcer)
+
+// WithClock configures the Enforcer to determine the age of snapshots relative to now
+// instead of time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(e *Enforcer) {
+		e.now = now
+	}
+}
+
+// WithConcurrency configures the number of devices processed concurrently, 1 by default.
+// The snapshots of a single device are always deleted one after another.
+func WithConcurrency(concurrency int) Option {
+	return func(e *Enforcer) {
+		e.concurrency = concurrency
+	}
+}
+
+// WithWaitOptions configures how Execute waits for a deletion to complete before the
+// next snapshot of the device is deleted.
+func WithWaitOptions(opts *xelon.WaitOptions) Option {
+	return func(e *Enforcer) {
+		e.waitOptions = opts
+	}
+}
+
+// Enforcer applies a Policy to the snapshots of all devices of a tenant.
+type Enforcer struct {
+	devices   xelon.DevicesAPI
+	snapshots xelon.SnapshotsAPI
+	policy    Policy
+
+	now         func() time.Time   // Current time, time.Now by default.
+	concurrency int                // Number of devices processed concurrently.
+	waitOptions *xelon.WaitOptions // Options to wait for a deletion, defaults if nil.
+}
+
+// New returns an Enforcer of policy using the given services, usually client.Devices
+// and client.Snapshots. It returns an error if the policy is invalid.
+func New(devices xelon.DevicesAPI, snapshots xelon.SnapshotsAPI, policy Policy, opts ...Option) (*Enforcer, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid retention policy: %w", err)
+	}
+
+	e := &Enforcer{
+		devices:     devices,
+		snapshots:   snapshots,
+		policy:      policy,
+		now:         time.Now,
+		concurrency: 1,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e, nil
+}
+
+// Evaluate returns the deletions of the policy for all devices of the tenant identified
+// by id without deleting anything. The devices are filtered by tenant on the server,
+// devices of other tenants returned anyway are skipped.
+func (e *Enforcer) Evaluate(ctx context.Context, tenantID string) (*Plan, error) {
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant id: %w", xelon.ErrEmptyArgument)
+	}
+
+	now := e.now()
+	plan := new(Plan)
+	devices, errFn := e.devices.All(ctx, &xelon.DeviceListOptions{TenantID: tenantID})
+	for device := range devices {
+		if device.TenantID != tenantID {
+			continue
+		}
+
+		tree, err := e.snapshots.Tree(ctx, device.ID)
+		if err != nil {
+			return nil, fmt.Errorf("device %v: %w", device.ID, err)
+		}
+		plan.Deletions = append(plan.Deletions, e.policy.Evaluate(device.ID, tree, now)...)
+	}
+	if err := errFn(); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// Execute deletes the snapshots of plan and returns the outcome of every deletion in the
+// order of the plan. The snapshots of a device are deleted in the order of the plan,
+// each after the previous deletion completed. If a deletion fails, the remaining ones of
+// the device fail with ErrSkipped.
+func (e *Enforcer) Execute(ctx context.Context, plan *Plan) xelon.BulkResults[Deletion] {
+	if plan == nil {
+		return nil
+	}
+
+	groups := plan.devices()
+	outcomes := make([]xelon.BulkResults[Deletion], len(groups))
+	indexes := make([]int, len(groups))
+	for i := range groups {
+		indexes[i] = i
+	}
+	xelon.Bulk(ctx, indexes, e.concurrency, func(ctx context.Context, i int) error {
+		outcomes[i] = e.executeDevice(ctx, groups[i])
+		return nil
+	})
+
+	var results xelon.BulkResults[Deletion]
+	for i, group := range groups {
+		if outcomes[i] == nil {
+			// the device was not processed because ctx has been canceled
+			outcomes[i] = skipped(group, ctx.Err())
+		}
+		results = append(results, outcomes[i]...)
+	}
+	return results
+}
+
+// executeDevice deletes the snapshots of a single device one after another.
+func (e *Enforcer) executeDevice(ctx context.Context, deletions []Deletion) xelon.BulkResults[Deletion] {
+	results := make(xelon.BulkResults[Deletion], 0, len(deletions))
+	for i, deletion := range deletions {
+		if err := e.delete(ctx, deletion); err != nil {
+			results = append(results, xelon.BulkResult[Deletion]{Item: deletion, Err: err})
+			return append(results, skipped(deletions[i+1:], ErrSkipped)...)
+		}
+		results = append(results, xelon.BulkResult[Deletion]{Item: deletion})
+	}
+	return results
+}
+
+// delete removes the snapshot of deletion without its children and waits until it is gone.
+func (e *Enforcer) delete(ctx context.Context, deletion Deletion) error {
+	deviceID, snapshotID := deletion.DeviceID, deletion.Snapshot.ID
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if _, err := e.snapshots.Delete(ctx, deviceID, snapshotID, &xelon.SnapshotDeleteRequest{RemoveChildSnapshots: false}); err != nil {
+		return err
+	}
+	return e.snapshots.WaitForDeletion(ctx, deviceID, snapshotID, e.waitOptions)
+}
+
+// skipped returns failed results for deletions.
+func skipped(deletions []Deletion, err error) xelon.BulkResults[Deletion] {
+	results := make(xelon.BulkResults[Deletion], 0, len(deletions))
+	for _, deletion := range deletions {
+		results = append(results, xelon.BulkResult[Deletion]{Item: deletion, Err: err})
+	}
+	return results
+}
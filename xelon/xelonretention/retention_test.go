@@ -0,0 +1,199 @@
+package xelonretention
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+	"github.com/Xelon-AG/xelon-sdk-go/xelon/xelonmock"
+)
+
+var now = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+func daysAgo(days int) *time.Time {
+	t := now.Add(-time.Duration(days) * 24 * time.Hour)
+	return &t
+}
+
+func deletedIDs(deletions []Deletion) []string {
+	var ids []string
+	for _, deletion := range deletions {
+		ids = append(ids, deletion.Snapshot.ID)
+	}
+	return ids
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	assert.EqualError(t, Policy{}.Validate(), "keep last or max age must be set")
+	assert.EqualError(t, Policy{KeepLast: -1}.Validate(), "keep last must not be negative")
+	assert.EqualError(t, Policy{MaxAge: -time.Hour}.Validate(), "max age must not be negative")
+	assert.NoError(t, Policy{KeepLast: 3}.Validate())
+}
+
+func TestPolicy_Evaluate(t *testing.T) {
+	// daily-1 is the oldest snapshot, daily-4 the child of daily-3 and so on
+	snapshots := []xelon.Snapshot{
+		{ID: "snap-1", Name: "daily-1", CreatedAt: daysAgo(40)},
+		{ID: "snap-2", Name: "daily-2", CreatedAt: daysAgo(20), ParentID: "snap-1"},
+		{ID: "snap-3", Name: "manual #keep", CreatedAt: daysAgo(15), ParentID: "snap-2"},
+		{ID: "snap-4", Name: "daily-3", CreatedAt: daysAgo(10), ParentID: "snap-3"},
+		{ID: "snap-5", Name: "daily-4", CreatedAt: daysAgo(5), ParentID: "snap-4"},
+		{ID: "snap-6", Name: "daily-5", CreatedAt: daysAgo(1), ParentID: "snap-5", Current: true},
+		{ID: "snap-7", Name: "imported", ParentID: "snap-1"},
+	}
+
+	type test struct {
+		policy      Policy
+		expectedIDs []string
+	}
+	tests := map[string]test{
+		"keep last": {
+			policy:      Policy{KeepLast: 2},
+			expectedIDs: []string{"snap-3", "snap-2", "snap-1"},
+		},
+		"max age": {
+			policy:      Policy{MaxAge: 30 * 24 * time.Hour},
+			expectedIDs: []string{"snap-1"},
+		},
+		"keep last and max age": {
+			policy:      Policy{KeepLast: 10, MaxAge: 12 * 24 * time.Hour},
+			expectedIDs: []string{"snap-3", "snap-2", "snap-1"},
+		},
+		"keep tagged": {
+			policy:      Policy{KeepLast: 2, KeepTag: "#keep"},
+			expectedIDs: []string{"snap-2", "snap-1"},
+		},
+		"name pattern": {
+			policy:      Policy{KeepLast: 1, NamePattern: regexp.MustCompile(`^daily-`)},
+			expectedIDs: []string{"snap-4", "snap-2", "snap-1"},
+		},
+		"nothing to delete": {
+			policy: Policy{KeepLast: 10},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			deletions := tc.policy.Evaluate("dev-1", xelon.NewSnapshotTree(snapshots), now)
+
+			assert.Equal(t, tc.expectedIDs, deletedIDs(deletions))
+			for _, deletion := range deletions {
+				assert.Equal(t, "dev-1", deletion.DeviceID)
+				assert.NotEmpty(t, deletion.Reason)
+			}
+		})
+	}
+}
+
+func TestEnforcer(t *testing.T) {
+	snapshots := map[string][]xelon.Snapshot{
+		"dev-1": {
+			{ID: "snap-1", Name: "old", CreatedAt: daysAgo(40)},
+			{ID: "snap-2", Name: "older child", CreatedAt: daysAgo(35), ParentID: "snap-1"},
+			{ID: "snap-3", Name: "new", CreatedAt: daysAgo(1), ParentID: "snap-1", Current: true},
+		},
+		"dev-2": {
+			{ID: "snap-4", Name: "new", CreatedAt: daysAgo(2)},
+		},
+		"dev-3": {
+			{ID: "snap-5", Name: "old", CreatedAt: daysAgo(50)},
+			{ID: "snap-6", Name: "older child", CreatedAt: daysAgo(45), ParentID: "snap-5"},
+		},
+		"dev-4": {
+			{ID: "snap-7", Name: "old of another tenant", CreatedAt: daysAgo(60)},
+		},
+	}
+
+	var mu sync.Mutex
+	var deleted []string
+	devices := &xelonmock.DevicesAPI{
+		AllFunc: func(ctx context.Context, opts *xelon.DeviceListOptions, paginatorOpts ...xelon.PaginatorOption) (iter.Seq2[xelon.Device, *xelon.Response], func() error) {
+			if assert.NotNil(t, opts) {
+				assert.Equal(t, "tenant-1", opts.TenantID)
+			}
+			// dev-4 is returned although it belongs to another tenant
+			return func(yield func(xelon.Device, *xelon.Response) bool) {
+				for _, device := range []xelon.Device{
+					{ID: "dev-1", TenantID: "tenant-1"},
+					{ID: "dev-2", TenantID: "tenant-1"},
+					{ID: "dev-3", TenantID: "tenant-1"},
+					{ID: "dev-4", TenantID: "tenant-2"},
+				} {
+					if !yield(device, nil) {
+						return
+					}
+				}
+			}, func() error { return nil }
+		},
+	}
+	snapshotsAPI := &xelonmock.SnapshotsAPI{
+		TreeFunc: func(ctx context.Context, deviceID string) (*xelon.SnapshotTree, error) {
+			assert.NotEqual(t, "dev-4", deviceID, "devices of other tenants must be skipped")
+			return xelon.NewSnapshotTree(snapshots[deviceID]), nil
+		},
+		DeleteFunc: func(ctx context.Context, deviceID, snapshotID string, deleteRequest *xelon.SnapshotDeleteRequest) (*xelon.Response, error) {
+			assert.False(t, deleteRequest.RemoveChildSnapshots)
+			if snapshotID == "snap-6" {
+				return nil, errors.New("snapshot is locked")
+			}
+			mu.Lock()
+			deleted = append(deleted, snapshotID)
+			mu.Unlock()
+			return nil, nil
+		},
+		WaitForDeletionFunc: func(ctx context.Context, deviceID, snapshotID string, opts *xelon.WaitOptions) error {
+			return nil
+		},
+	}
+
+	enforcer, err := New(devices, snapshotsAPI, Policy{MaxAge: 30 * 24 * time.Hour}, WithClock(func() time.Time { return now }), WithConcurrency(2))
+	require.NoError(t, err)
+
+	plan, err := enforcer.Evaluate(context.Background(), "tenant-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"snap-2", "snap-1", "snap-6", "snap-5"}, deletedIDs(plan.Deletions))
+	assert.Equal(t, `- dev-1/snap-2 "older child" (older than 720h0m0s)
+- dev-1/snap-1 "old" (older than 720h0m0s)
+- dev-3/snap-6 "older child" (older than 720h0m0s)
+- dev-3/snap-5 "old" (older than 720h0m0s)
+
+Plan: 4 snapshots of 2 devices to delete.
+`, plan.String())
+	assert.Empty(t, deleted, "evaluation must not delete snapshots")
+
+	results := enforcer.Execute(context.Background(), plan)
+
+	assert.Equal(t, []string{"snap-2", "snap-1"}, deleted)
+	require.Len(t, results, 4)
+	assert.NoError(t, results[0].Err)
+	assert.NoError(t, results[1].Err)
+	assert.EqualError(t, results[2].Err, "snapshot is locked")
+	assert.ErrorIs(t, results[3].Err, ErrSkipped)
+}
+
+func TestNew_invalidPolicy(t *testing.T) {
+	_, err := New(&xelonmock.DevicesAPI{}, &xelonmock.SnapshotsAPI{}, Policy{})
+
+	assert.EqualError(t, err, "invalid retention policy: keep last or max age must be set")
+}
+
+func TestEnforcer_Evaluate_emptyTenant(t *testing.T) {
+	enforcer, err := New(&xelonmock.DevicesAPI{}, &xelonmock.SnapshotsAPI{}, Policy{KeepLast: 1})
+	require.NoError(t, err)
+
+	_, err = enforcer.Evaluate(context.Background(), "")
+
+	assert.ErrorIs(t, err, xelon.ErrEmptyArgument)
+}
+
+func TestPlan_String_empty(t *testing.T) {
+	assert.Equal(t, "No snapshots to delete.\n", (&Plan{}).String())
+}
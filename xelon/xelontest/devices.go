@@ -0,0 +1,196 @@
+package xelontest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func (s *Server) registerDevices() {
+	s.mux.HandleFunc("GET /devices", func(w http.ResponseWriter, r *http.Request) {
+		devices := search(r, s.Devices.List(), func(v xelon.Device) string { return v.DisplayName })
+		writePage(w, r, devices)
+	})
+
+	s.mux.HandleFunc("GET /devices/{id}", func(w http.ResponseWriter, r *http.Request) {
+		device, ok := s.Devices.Get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "device")
+			return
+		}
+		writeJSON(w, http.StatusOK, device)
+	})
+
+	s.mux.HandleFunc("GET /devices/{id}/network", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.Devices.Get(r.PathValue("id")); !ok {
+			writeNotFound(w, "device")
+			return
+		}
+		writeJSON(w, http.StatusOK, []xelon.DeviceNetwork{})
+	})
+
+	s.mux.HandleFunc("POST /devices", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.DeviceCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("displayName", createRequest.DisplayName != "")
+		v.require("hostName", createRequest.HostName != "")
+		v.require("templateId", createRequest.TemplateID != "")
+		v.require("cpu", createRequest.CPUCores > 0)
+		v.require("ram", createRequest.RAM > 0)
+		v.require("diskSize", createRequest.DiskSize > 0)
+		v.check("passwordConfirmation", createRequest.Password == createRequest.PasswordConfirmation,
+			"The password confirmation does not match.")
+		if v.write(w) {
+			return
+		}
+
+		tenantID := createRequest.TenantID
+		if tenantID == "" {
+			tenantID = s.CurrentTenant().ID
+		}
+		device := s.Devices.Add(xelon.Device{
+			CPUCores:              createRequest.CPUCores,
+			CPUCoresHotAddEnabled: createRequest.EnableCPUCoresHotAdd,
+			DisplayName:           createRequest.DisplayName,
+			HostName:              createRequest.HostName,
+			PoweredOn:             true,
+			RAM:                   createRequest.RAM,
+			RAMHotAddEnabled:      createRequest.EnableRAMHotAdd,
+			Storages: []xelon.DeviceStorage{
+				{ID: s.newID(), Name: "Hard disk 1", Size: createRequest.DiskSize},
+			},
+			TemplateID: createRequest.TemplateID,
+			TenantID:   tenantID,
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: device, Message: "Device created"})
+	})
+
+	s.mux.HandleFunc("PUT /devices/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.DeviceUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("displayName", updateRequest.DisplayName != "")
+		if v.write(w) {
+			return
+		}
+
+		s.updateDevice(w, r, func(device *xelon.Device) {
+			device.DisplayName = updateRequest.DisplayName
+		})
+	})
+
+	s.mux.HandleFunc("PUT /devices/{id}/hardware", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.DeviceUpdateHardwareRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("cpu", updateRequest.CPUCores > 0)
+		v.require("ram", updateRequest.RAM > 0)
+		if v.write(w) {
+			return
+		}
+
+		s.updateDevice(w, r, func(device *xelon.Device) {
+			device.CPUCores = updateRequest.CPUCores
+			device.RAM = updateRequest.RAM
+		})
+	})
+
+	s.mux.HandleFunc("PUT /devices/{id}/disk", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.DeviceUpdateDiskRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		device, ok := s.Devices.Get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "device")
+			return
+		}
+		v := validation{}
+		v.require("diskId", updateRequest.DiskID != "")
+		for _, storage := range device.Storages {
+			if storage.ID == updateRequest.DiskID {
+				v.check("size", updateRequest.Size >= storage.Size, "The disk size cannot be reduced.")
+			}
+		}
+		if v.write(w) {
+			return
+		}
+
+		s.updateDevice(w, r, func(device *xelon.Device) {
+			for i := range device.Storages {
+				if device.Storages[i].ID == updateRequest.DiskID {
+					device.Storages[i].Size = updateRequest.Size
+				}
+			}
+		})
+	})
+
+	s.mux.HandleFunc("POST /devices/{id}/edit-hotplug", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.DeviceUpdateHotAddRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		s.updateDevice(w, r, func(device *xelon.Device) {
+			device.CPUCoresHotAddEnabled = updateRequest.EnableCPUCoresHotAdd
+			device.RAMHotAddEnabled = updateRequest.EnableRAMHotAdd
+		})
+	})
+
+	for _, action := range []xelon.PowerAction{
+		xelon.PowerActionStart,
+		xelon.PowerActionStop,
+		xelon.PowerActionShutdown,
+		xelon.PowerActionReboot,
+		xelon.PowerActionReset,
+		xelon.PowerActionSuspend,
+		xelon.PowerActionResume,
+	} {
+		s.mux.HandleFunc(fmt.Sprintf("POST /devices/{id}/%v", action), s.powerDevice(action))
+	}
+
+	s.mux.HandleFunc("DELETE /devices/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.Devices.Delete(r.PathValue("id")) {
+			writeNotFound(w, "device")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// updateDevice applies fn to the device identified by the "id" path value and writes it.
+func (s *Server) updateDevice(w http.ResponseWriter, r *http.Request, fn func(device *xelon.Device)) {
+	device, ok := s.Devices.Update(r.PathValue("id"), fn)
+	if !ok {
+		writeNotFound(w, "device")
+		return
+	}
+	writeJSON(w, http.StatusOK, dataRoot{Data: device, Message: "Device updated"})
+}
+
+// powerDevice returns a handler which sets the power state of the device to the one
+// after action.
+func (s *Server) powerDevice(action xelon.PowerAction) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, ok := s.Devices.Update(r.PathValue("id"), func(device *xelon.Device) {
+			device.PoweredOn = action.PoweredOn()
+		})
+		if !ok {
+			writeNotFound(w, "device")
+			return
+		}
+		writeMessage(w, fmt.Sprintf("Device %v action executed", action))
+	}
+}
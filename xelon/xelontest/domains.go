@@ -0,0 +1,148 @@
+package xelontest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func (s *Server) registerDomains() {
+	s.mux.HandleFunc("GET /dns", func(w http.ResponseWriter, r *http.Request) {
+		dnsZones := search(r, s.DNSZones.List(), func(v xelon.DNSZone) string { return v.Name })
+		writePage(w, r, dnsZones)
+	})
+
+	s.mux.HandleFunc("GET /dns/{id}", func(w http.ResponseWriter, r *http.Request) {
+		dnsZone, ok := s.DNSZones.Get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "dns zone")
+			return
+		}
+		writeJSON(w, http.StatusOK, dnsZone)
+	})
+
+	s.mux.HandleFunc("POST /dns", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.DNSZoneCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		domain := strings.ToLower(strings.TrimSuffix(createRequest.Domain, "."))
+		_, exists := s.DNSZones.Find(func(v xelon.DNSZone) bool { return v.Name == domain })
+		v := validation{}
+		v.require("domain", domain != "")
+		v.check("domain", !exists, "The domain has already been taken.")
+		if v.write(w) {
+			return
+		}
+
+		dnsZone := s.DNSZones.Add(xelon.DNSZone{
+			CreatedAt: now(),
+			Name:      domain,
+			OwnerName: s.CurrentTenant().Name,
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: dnsZone})
+	})
+
+	s.mux.HandleFunc("DELETE /dns/{id}", func(w http.ResponseWriter, r *http.Request) {
+		dnsZoneID := r.PathValue("id")
+		if !s.DNSZones.Delete(dnsZoneID) {
+			writeNotFound(w, "dns zone")
+			return
+		}
+		for _, record := range s.DNSRecords.List() {
+			if record.ZoneID == dnsZoneID {
+				s.DNSRecords.Delete(s.DNSRecords.key(record))
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	s.mux.HandleFunc("GET /dns/{id}/records", func(w http.ResponseWriter, r *http.Request) {
+		dnsZoneID := r.PathValue("id")
+		if _, ok := s.DNSZones.Get(dnsZoneID); !ok {
+			writeNotFound(w, "dns zone")
+			return
+		}
+
+		records := []xelon.DNSRecord{}
+		for _, record := range s.DNSRecords.List() {
+			if record.ZoneID == dnsZoneID {
+				records = append(records, record.DNSRecord)
+			}
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: records})
+	})
+
+	s.mux.HandleFunc("POST /dns/{id}/records", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.DNSRecordCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		dnsZoneID := r.PathValue("id")
+		if _, ok := s.DNSZones.Get(dnsZoneID); !ok {
+			writeNotFound(w, "dns zone")
+			return
+		}
+		v := validation{}
+		v.require("host", createRequest.Host != "")
+		v.require("ttl", createRequest.TTL > 0)
+		v.require("type", createRequest.Type != "")
+		if v.write(w) {
+			return
+		}
+
+		s.DNSRecords.Add(DNSRecord{
+			ZoneID: dnsZoneID,
+			DNSRecord: xelon.DNSRecord{
+				Host:   createRequest.Host,
+				Record: createRequest.Record,
+				Status: 1,
+				TTL:    createRequest.TTL,
+				Type:   createRequest.Type,
+			},
+		})
+		writeJSON(w, http.StatusCreated, messageRoot{Message: "Record created"})
+	})
+
+	s.mux.HandleFunc("PUT /dns/{id}/records/{recordID}", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.DNSRecordUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("host", updateRequest.Host != "")
+		v.require("ttl", updateRequest.TTL > 0)
+		v.require("type", updateRequest.Type != "")
+		if v.write(w) {
+			return
+		}
+
+		dnsZoneID := r.PathValue("id")
+		record, ok := s.DNSRecords.Get(r.PathValue("recordID"))
+		if !ok || record.ZoneID != dnsZoneID {
+			writeNotFound(w, "dns record")
+			return
+		}
+		s.DNSRecords.Update(r.PathValue("recordID"), func(record *DNSRecord) {
+			record.Host = updateRequest.Host
+			record.Record = updateRequest.Record
+			record.TTL = updateRequest.TTL
+			record.Type = updateRequest.Type
+		})
+		writeMessage(w, "Record updated")
+	})
+
+	s.mux.HandleFunc("DELETE /dns/{id}/records/{recordID}", func(w http.ResponseWriter, r *http.Request) {
+		record, ok := s.DNSRecords.Get(r.PathValue("recordID"))
+		if !ok || record.ZoneID != r.PathValue("id") {
+			writeNotFound(w, "dns record")
+			return
+		}
+		s.DNSRecords.Delete(r.PathValue("recordID"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,149 @@
+package xelontest
+
+import (
+	"net/http"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func (s *Server) registerFirewalls() {
+	s.mux.HandleFunc("GET /firewalls", func(w http.ResponseWriter, r *http.Request) {
+		firewalls := search(r, s.Firewalls.List(), func(v xelon.Firewall) string { return v.Name })
+		writePage(w, r, firewalls)
+	})
+
+	s.mux.HandleFunc("GET /firewalls/{id}", func(w http.ResponseWriter, r *http.Request) {
+		firewall, ok := s.Firewalls.Get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "firewall")
+			return
+		}
+		writeJSON(w, http.StatusOK, firewall)
+	})
+
+	s.mux.HandleFunc("POST /firewalls", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.FirewallCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("cloudIdentifier", createRequest.CloudID != "")
+		v.require("internalNetworkIdentifier", createRequest.InternalNetworkID != "")
+		v.require("name", createRequest.Name != "")
+		if v.write(w) {
+			return
+		}
+
+		firewall := s.Firewalls.Add(xelon.Firewall{
+			Cloud:             &xelon.Cloud{ID: createRequest.CloudID},
+			CreatedAt:         now(),
+			HealthStatus:      "healthy",
+			InternalIPAddress: createRequest.InternalIPAddress,
+			Name:              createRequest.Name,
+			Tenant:            s.owner(createRequest.TenantID),
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: firewall, Message: "Firewall created"})
+	})
+
+	s.mux.HandleFunc("PUT /firewalls/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.FirewallUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("name", updateRequest.Name != "")
+		if v.write(w) {
+			return
+		}
+
+		firewall, ok := s.Firewalls.Update(r.PathValue("id"), func(firewall *xelon.Firewall) {
+			firewall.Name = updateRequest.Name
+		})
+		if !ok {
+			writeNotFound(w, "firewall")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: firewall, Message: "Firewall updated"})
+	})
+
+	s.mux.HandleFunc("DELETE /firewalls/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.Firewalls.Delete(r.PathValue("id")) {
+			writeNotFound(w, "firewall")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	s.mux.HandleFunc("POST /firewalls/{id}/rules", func(w http.ResponseWriter, r *http.Request) {
+		var rule xelon.FirewallForwardingRule
+		if !decode(w, r, &rule) {
+			return
+		}
+
+		rule.ID = s.newID()
+		_, ok := s.Firewalls.Update(r.PathValue("id"), func(firewall *xelon.Firewall) {
+			firewall.ForwardingRules = append(firewall.ForwardingRules, rule)
+		})
+		if !ok {
+			writeNotFound(w, "firewall")
+			return
+		}
+		writeJSON(w, http.StatusCreated, dataRoot{Data: &rule, Message: "Forwarding rule created"})
+	})
+
+	s.mux.HandleFunc("PUT /firewalls/{id}/rules/{ruleID}", func(w http.ResponseWriter, r *http.Request) {
+		var rule xelon.FirewallForwardingRule
+		if !decode(w, r, &rule) {
+			return
+		}
+
+		rule.ID = r.PathValue("ruleID")
+		found := false
+		_, ok := s.Firewalls.Update(r.PathValue("id"), func(firewall *xelon.Firewall) {
+			for i := range firewall.ForwardingRules {
+				if firewall.ForwardingRules[i].ID == rule.ID {
+					firewall.ForwardingRules[i] = rule
+					found = true
+				}
+			}
+		})
+		if !ok {
+			writeNotFound(w, "firewall")
+			return
+		}
+		if !found {
+			writeNotFound(w, "forwarding rule")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: &rule, Message: "Forwarding rule updated"})
+	})
+
+	s.mux.HandleFunc("DELETE /firewalls/{id}/rules/{ruleID}", func(w http.ResponseWriter, r *http.Request) {
+		found := false
+		_, ok := s.Firewalls.Update(r.PathValue("id"), func(firewall *xelon.Firewall) {
+			firewall.ForwardingRules, found = deleteRule(firewall.ForwardingRules, r.PathValue("ruleID"),
+				func(rule xelon.FirewallForwardingRule) string { return rule.ID })
+		})
+		if !ok {
+			writeNotFound(w, "firewall")
+			return
+		}
+		if !found {
+			writeNotFound(w, "forwarding rule")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// deleteRule removes the rule identified by id from rules and reports whether it existed.
+func deleteRule[T any](rules []T, id string, key func(rule T) string) ([]T, bool) {
+	for i, rule := range rules {
+		if key(rule) == id {
+			return append(rules[:i:i], rules[i+1:]...), true
+		}
+	}
+	return rules, false
+}
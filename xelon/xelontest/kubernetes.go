@@ -0,0 +1,56 @@
+package xelontest
+
+import (
+	"net/http"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func (s *Server) registerKubernetes() {
+	s.mux.HandleFunc("GET /kubernetes", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.KubernetesClusters.List())
+	})
+
+	s.mux.HandleFunc("GET /kubernetes/{id}", func(w http.ResponseWriter, r *http.Request) {
+		cluster, ok := s.KubernetesClusters.Get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "kubernetes cluster")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: cluster})
+	})
+
+	s.mux.HandleFunc("POST /kubernetes", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.KubernetesClusterCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		_, exists := s.KubernetesClusters.Find(func(v xelon.KubernetesCluster) bool { return v.Name == createRequest.Name })
+		v := validation{}
+		v.require("cloudIdentifier", createRequest.CloudID != "")
+		v.require("clusterName", createRequest.Name != "")
+		v.check("clusterName", !exists, "The cluster name has already been taken.")
+		v.require("k8sVersion", createRequest.KubernetesVersion != "")
+		v.require("workerPool", len(createRequest.WorkerPools) > 0)
+		if v.write(w) {
+			return
+		}
+
+		cluster := s.KubernetesClusters.Add(xelon.KubernetesCluster{
+			Cloud:     &xelon.Cloud{ID: createRequest.CloudID},
+			CreatedAt: now(),
+			Name:      createRequest.Name,
+			Status:    xelon.KubernetesClusterStatusProvisioning,
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: cluster, Message: "Kubernetes cluster created"})
+	})
+
+	s.mux.HandleFunc("DELETE /kubernetes/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.KubernetesClusters.Delete(r.PathValue("id")) {
+			writeNotFound(w, "kubernetes cluster")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,172 @@
+package xelontest
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func (s *Server) registerLoadBalancers() {
+	s.mux.HandleFunc("GET /load-balancers", func(w http.ResponseWriter, r *http.Request) {
+		loadBalancers := search(r, s.LoadBalancers.List(), func(v xelon.LoadBalancer) string { return v.Name })
+		writePage(w, r, loadBalancers)
+	})
+
+	s.mux.HandleFunc("GET /load-balancers/{id}", func(w http.ResponseWriter, r *http.Request) {
+		loadBalancer, ok := s.LoadBalancers.Get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "load balancer")
+			return
+		}
+		writeJSON(w, http.StatusOK, loadBalancer)
+	})
+
+	s.mux.HandleFunc("POST /load-balancers", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.LoadBalancerCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("cloudIdentifier", createRequest.CloudID != "")
+		v.require("internalNetworkIdentifier", createRequest.InternalNetworkID != "")
+		v.require("name", createRequest.Name != "")
+		v.check("loadBalancingType", slices.Contains([]string{"layer4", "layer7"}, createRequest.Type),
+			"The selected load balancing type is invalid.")
+		if v.write(w) {
+			return
+		}
+
+		loadBalancer := s.LoadBalancers.Add(xelon.LoadBalancer{
+			AssignedDevices:   s.assignedDevices(createRequest.AssignedDeviceIDs),
+			Cloud:             &xelon.Cloud{ID: createRequest.CloudID},
+			CreatedAt:         now(),
+			ExternalNetworkID: createRequest.ExternalNetworkID,
+			HealthStatus:      "healthy",
+			InternalIPAddress: createRequest.InternalIPAddress,
+			InternalNetworkID: createRequest.InternalNetworkID,
+			Name:              createRequest.Name,
+			Tenant:            s.owner(createRequest.TenantID),
+			Type:              createRequest.Type,
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: loadBalancer, Message: "Load balancer created"})
+	})
+
+	s.mux.HandleFunc("PUT /load-balancers/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.LoadBalancerUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("name", updateRequest.Name != "")
+		if v.write(w) {
+			return
+		}
+
+		loadBalancer, ok := s.LoadBalancers.Update(r.PathValue("id"), func(loadBalancer *xelon.LoadBalancer) {
+			loadBalancer.Name = updateRequest.Name
+		})
+		if !ok {
+			writeNotFound(w, "load balancer")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: loadBalancer, Message: "Load balancer updated"})
+	})
+
+	s.mux.HandleFunc("DELETE /load-balancers/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.LoadBalancers.Delete(r.PathValue("id")) {
+			writeNotFound(w, "load balancer")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	s.mux.HandleFunc("PUT /load-balancers/{id}/assigned-devices", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.LoadBalancerUpdateAssignedDevicesRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		loadBalancer, ok := s.LoadBalancers.Update(r.PathValue("id"), func(loadBalancer *xelon.LoadBalancer) {
+			loadBalancer.AssignedDevices = s.assignedDevices(updateRequest.DeviceIDs)
+		})
+		if !ok {
+			writeNotFound(w, "load balancer")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: loadBalancer, Message: "Assigned devices updated"})
+	})
+
+	s.mux.HandleFunc("POST /load-balancers/{id}/rules", func(w http.ResponseWriter, r *http.Request) {
+		var rule xelon.LoadBalancerForwardingRule
+		if !decode(w, r, &rule) {
+			return
+		}
+
+		rule.ID = s.newID()
+		_, ok := s.LoadBalancers.Update(r.PathValue("id"), func(loadBalancer *xelon.LoadBalancer) {
+			loadBalancer.ForwardingRules = append(loadBalancer.ForwardingRules, rule)
+		})
+		if !ok {
+			writeNotFound(w, "load balancer")
+			return
+		}
+		writeJSON(w, http.StatusCreated, dataRoot{Data: rule, Message: "Forwarding rule created"})
+	})
+
+	s.mux.HandleFunc("PUT /load-balancers/{id}/rules/{ruleID}", func(w http.ResponseWriter, r *http.Request) {
+		var rule xelon.LoadBalancerForwardingRule
+		if !decode(w, r, &rule) {
+			return
+		}
+
+		rule.ID = r.PathValue("ruleID")
+		found := false
+		_, ok := s.LoadBalancers.Update(r.PathValue("id"), func(loadBalancer *xelon.LoadBalancer) {
+			for i := range loadBalancer.ForwardingRules {
+				if loadBalancer.ForwardingRules[i].ID == rule.ID {
+					loadBalancer.ForwardingRules[i] = rule
+					found = true
+				}
+			}
+		})
+		if !ok {
+			writeNotFound(w, "load balancer")
+			return
+		}
+		if !found {
+			writeNotFound(w, "forwarding rule")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: rule, Message: "Forwarding rule updated"})
+	})
+
+	s.mux.HandleFunc("DELETE /load-balancers/{id}/rules/{ruleID}", func(w http.ResponseWriter, r *http.Request) {
+		found := false
+		_, ok := s.LoadBalancers.Update(r.PathValue("id"), func(loadBalancer *xelon.LoadBalancer) {
+			loadBalancer.ForwardingRules, found = deleteRule(loadBalancer.ForwardingRules, r.PathValue("ruleID"),
+				func(rule xelon.LoadBalancerForwardingRule) string { return rule.ID })
+		})
+		if !ok {
+			writeNotFound(w, "load balancer")
+			return
+		}
+		if !found {
+			writeNotFound(w, "forwarding rule")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// assignedDevices resolves the device names of deviceIDs.
+func (s *Server) assignedDevices(deviceIDs []string) []xelon.LoadBalancerAssignedDevice {
+	var assignedDevices []xelon.LoadBalancerAssignedDevice
+	for _, deviceID := range deviceIDs {
+		device, _ := s.Devices.Get(deviceID)
+		assignedDevices = append(assignedDevices, xelon.LoadBalancerAssignedDevice{ID: deviceID, Name: device.DisplayName})
+	}
+	return assignedDevices
+}
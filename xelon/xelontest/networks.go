@@ -0,0 +1,150 @@
+package xelontest
+
+import (
+	"net/http"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func (s *Server) registerNetworks() {
+	s.mux.HandleFunc("GET /networks", func(w http.ResponseWriter, r *http.Request) {
+		networks := search(r, s.Networks.List(), func(v xelon.Network) string { return v.Name })
+		writePage(w, r, networks)
+	})
+
+	s.mux.HandleFunc("GET /networks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		network, ok := s.Networks.Get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "network")
+			return
+		}
+		writeJSON(w, http.StatusOK, network)
+	})
+
+	s.mux.HandleFunc("POST /networks/lan", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.NetworkLANCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("cloudIdentifier", createRequest.CloudID != "")
+		v.require("name", createRequest.Name != "")
+		v.require("network", createRequest.Network != "")
+		v.require("gateway", createRequest.Gateway != "")
+		v.require("dns1", createRequest.DNSPrimary != "")
+		v.require("networkSize", createRequest.SubnetSize > 0)
+		if v.write(w) {
+			return
+		}
+
+		network := s.Networks.Add(xelon.Network{
+			Clouds:       []xelon.Cloud{{ID: createRequest.CloudID}},
+			DNSPrimary:   createRequest.DNSPrimary,
+			DNSSecondary: createRequest.DNSSecondary,
+			Gateway:      createRequest.Gateway,
+			Name:         createRequest.Name,
+			Network:      createRequest.Network,
+			NetworkSpeed: createRequest.NetworkSpeed,
+			Owner:        s.owner(createRequest.TenantID),
+			Stretched:    createRequest.Stretched,
+			SubnetSize:   createRequest.SubnetSize,
+			Type:         "LAN",
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: network, Message: "Network created"})
+	})
+
+	s.mux.HandleFunc("POST /networks/wan", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.NetworkWANCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("cloudIdentifier", createRequest.CloudID != "")
+		v.require("name", createRequest.Name != "")
+		v.require("networkSize", createRequest.SubnetSize > 0)
+		if v.write(w) {
+			return
+		}
+
+		network := s.Networks.Add(xelon.Network{
+			Clouds:       []xelon.Cloud{{ID: createRequest.CloudID}},
+			Name:         createRequest.Name,
+			NetworkSpeed: createRequest.NetworkSpeed,
+			Owner:        s.owner(createRequest.TenantID),
+			Stretched:    createRequest.Stretched,
+			SubnetSize:   createRequest.SubnetSize,
+			Type:         "WAN",
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: network, Message: "Network created"})
+	})
+
+	s.mux.HandleFunc("PATCH /networks/{id}/lan", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.NetworkLANUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		s.updateNetwork(w, r, "LAN", func(network *xelon.Network) {
+			network.DNSPrimary = updateRequest.DNSPrimary
+			network.DNSSecondary = updateRequest.DNSSecondary
+			network.Gateway = updateRequest.Gateway
+			network.Name = updateRequest.Name
+			network.Network = updateRequest.Network
+			network.NetworkSpeed = updateRequest.NetworkSpeed
+		})
+	})
+
+	s.mux.HandleFunc("PATCH /networks/{id}/wan", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.NetworkWANUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		s.updateNetwork(w, r, "WAN", func(network *xelon.Network) {
+			network.DNSPrimary = updateRequest.DNSPrimary
+			network.DNSSecondary = updateRequest.DNSSecondary
+			network.Gateway = updateRequest.Gateway
+			network.Name = updateRequest.Name
+			network.NetworkSpeed = updateRequest.NetworkSpeed
+		})
+	})
+
+	s.mux.HandleFunc("DELETE /networks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.Networks.Delete(r.PathValue("id")) {
+			writeNotFound(w, "network")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// updateNetwork applies fn to the network of type networkType identified by the "id"
+// path value and writes it.
+func (s *Server) updateNetwork(w http.ResponseWriter, r *http.Request, networkType string, fn func(network *xelon.Network)) {
+	network, ok := s.Networks.Get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, "network")
+		return
+	}
+	if network.Type != networkType {
+		writeError(w, http.StatusUnprocessableEntity, "The network is not a "+networkType+" network.")
+		return
+	}
+
+	network, _ = s.Networks.Update(network.ID, fn)
+	writeJSON(w, http.StatusOK, network)
+}
+
+// owner returns the tenant identified by tenantID or the current tenant if tenantID is empty.
+func (s *Server) owner(tenantID string) *xelon.Tenant {
+	if tenantID != "" {
+		if tenant, ok := s.Tenants.Get(tenantID); ok {
+			return &tenant
+		}
+		return &xelon.Tenant{ID: tenantID}
+	}
+	tenant := s.CurrentTenant()
+	return &tenant
+}
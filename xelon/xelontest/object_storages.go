@@ -0,0 +1,242 @@
+package xelontest
+
+import (
+	"crypto/rand"
+	"net/http"
+	"strings"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+const s3Endpoint = "https://s3.xelontest.local"
+
+func (s *Server) registerObjectStorages() {
+	s.mux.HandleFunc("GET /object-storages/users", func(w http.ResponseWriter, r *http.Request) {
+		users := filterTenant(r, s.ObjectStorageUsers.List(), func(v xelon.ObjectStorageUser) *xelon.Tenant { return v.Tenant })
+		writePage(w, r, users)
+	})
+
+	s.mux.HandleFunc("GET /object-storages/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		user, ok := s.ObjectStorageUsers.Get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "object storage user")
+			return
+		}
+		writeJSON(w, http.StatusOK, user)
+	})
+
+	s.mux.HandleFunc("POST /object-storages/users", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.ObjectStorageUserCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("name", createRequest.Name != "")
+		v.require("quota", createRequest.QuotaGB > 0)
+		v.require("zoneGroup", createRequest.RegionID != "")
+		if v.write(w) {
+			return
+		}
+
+		user := s.ObjectStorageUsers.Add(xelon.ObjectStorageUser{
+			Name:        createRequest.Name,
+			QuotaGB:     createRequest.QuotaGB,
+			S3Endpoints: []string{s3Endpoint},
+			Tenant:      s.owner(createRequest.TenantID),
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: user})
+	})
+
+	s.mux.HandleFunc("PUT /object-storages/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.ObjectStorageUserUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("name", updateRequest.Name != "")
+		v.require("quota", updateRequest.QuotaGB > 0)
+		if v.write(w) {
+			return
+		}
+
+		user, ok := s.ObjectStorageUsers.Update(r.PathValue("id"), func(user *xelon.ObjectStorageUser) {
+			user.Name = updateRequest.Name
+			user.QuotaGB = updateRequest.QuotaGB
+		})
+		if !ok {
+			writeNotFound(w, "object storage user")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: user})
+	})
+
+	s.mux.HandleFunc("DELETE /object-storages/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		userID := r.PathValue("id")
+		if _, ok := s.ObjectStorageUsers.Get(userID); !ok {
+			writeNotFound(w, "object storage user")
+			return
+		}
+		if _, ok := s.ObjectStorageBuckets.Find(func(v xelon.ObjectStorageBucket) bool { return v.ObjectStorageUserID == userID }); ok {
+			writeError(w, http.StatusConflict, "The object storage user still owns buckets.")
+			return
+		}
+		s.ObjectStorageUsers.Delete(userID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	s.mux.HandleFunc("POST /object-storages/users/{id}/tokens", func(w http.ResponseWriter, r *http.Request) {
+		token := xelon.ObjectStorageUserToken{
+			AccessKey: strings.ToUpper(rand.Text()),
+			CreatedAt: now(),
+			ID:        s.newID(),
+		}
+		_, ok := s.ObjectStorageUsers.Update(r.PathValue("id"), func(user *xelon.ObjectStorageUser) {
+			// the secret key is returned only once
+			user.Tokens = append(user.Tokens, token)
+		})
+		if !ok {
+			writeNotFound(w, "object storage user")
+			return
+		}
+		token.SecretKey = rand.Text() + rand.Text()
+		writeJSON(w, http.StatusCreated, dataRoot{Data: token})
+	})
+
+	s.mux.HandleFunc("DELETE /object-storages/users/{id}/tokens/{tokenID}", func(w http.ResponseWriter, r *http.Request) {
+		found := false
+		_, ok := s.ObjectStorageUsers.Update(r.PathValue("id"), func(user *xelon.ObjectStorageUser) {
+			user.Tokens, found = deleteRule(user.Tokens, r.PathValue("tokenID"),
+				func(token xelon.ObjectStorageUserToken) string { return token.ID })
+		})
+		if !ok {
+			writeNotFound(w, "object storage user")
+			return
+		}
+		if !found {
+			writeNotFound(w, "object storage user token")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	s.mux.HandleFunc("GET /object-storages/buckets", func(w http.ResponseWriter, r *http.Request) {
+		buckets := filterTenant(r, s.ObjectStorageBuckets.List(), func(v xelon.ObjectStorageBucket) *xelon.Tenant { return v.Tenant })
+		writePage(w, r, buckets)
+	})
+
+	s.mux.HandleFunc("GET /object-storages/buckets/{name}/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		bucket, ok := s.bucket(r)
+		if !ok {
+			writeNotFound(w, "object storage bucket")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: bucket})
+	})
+
+	s.mux.HandleFunc("POST /object-storages/buckets", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.ObjectStorageBucketCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		user, userExists := s.ObjectStorageUsers.Get(createRequest.ObjectStorageUserID)
+		_, bucketExists := s.ObjectStorageBuckets.Find(func(v xelon.ObjectStorageBucket) bool { return v.Name == createRequest.Name })
+		v := validation{}
+		v.require("name", createRequest.Name != "")
+		v.check("name", !bucketExists, "The name has already been taken.")
+		v.require("s3UserIdentifier", createRequest.ObjectStorageUserID != "")
+		v.check("s3UserIdentifier", createRequest.ObjectStorageUserID == "" || userExists, "The selected s3 user identifier is invalid.")
+		v.check("retentionPeriodDays", !createRequest.ObjectLockEnabled || createRequest.ObjectLockRetentionDays > 0,
+			"The retention period days field is required when object lock is enabled.")
+		if v.write(w) {
+			return
+		}
+
+		bucket := s.ObjectStorageBuckets.Add(xelon.ObjectStorageBucket{
+			CreatedAt:               now(),
+			Name:                    createRequest.Name,
+			ObjectLockEnabled:       createRequest.ObjectLockEnabled,
+			ObjectLockRetentionDays: createRequest.ObjectLockRetentionDays,
+			ObjectStorageUserID:     user.ID,
+			ObjectStorageUserName:   user.Name,
+			S3Endpoints:             user.S3Endpoints,
+			Tenant:                  user.Tenant,
+			VersioningEnabled:       createRequest.VersioningEnabled,
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: bucket})
+	})
+
+	s.mux.HandleFunc("PUT /object-storages/buckets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.ObjectStorageBucketUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		v := validation{}
+		v.require("name", updateRequest.Name != "")
+		if v.write(w) {
+			return
+		}
+
+		bucket, ok := s.ObjectStorageBuckets.Update(r.PathValue("id"), func(bucket *xelon.ObjectStorageBucket) {
+			bucket.Name = updateRequest.Name
+		})
+		if !ok {
+			writeNotFound(w, "object storage bucket")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: bucket})
+	})
+
+	s.mux.HandleFunc("PUT /object-storages/buckets/{name}/{userID}/versioning", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.ObjectStorageBucketVersioningUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		bucket, ok := s.bucket(r)
+		if !ok {
+			writeNotFound(w, "object storage bucket")
+			return
+		}
+		bucket, _ = s.ObjectStorageBuckets.Update(bucket.ID, func(bucket *xelon.ObjectStorageBucket) {
+			bucket.VersioningEnabled = updateRequest.VersioningEnabled
+		})
+		writeJSON(w, http.StatusOK, dataRoot{Data: bucket})
+	})
+
+	s.mux.HandleFunc("DELETE /object-storages/buckets/{name}/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		bucket, ok := s.bucket(r)
+		if !ok {
+			writeNotFound(w, "object storage bucket")
+			return
+		}
+		s.ObjectStorageBuckets.Delete(bucket.ID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// bucket returns the bucket identified by the "name" and "userID" path values.
+func (s *Server) bucket(r *http.Request) (xelon.ObjectStorageBucket, bool) {
+	return s.ObjectStorageBuckets.Find(func(v xelon.ObjectStorageBucket) bool {
+		return v.Name == r.PathValue("name") && v.ObjectStorageUserID == r.PathValue("userID")
+	})
+}
+
+// filterTenant returns the items owned by the tenant in the "tenantId" query parameter.
+func filterTenant[T any](r *http.Request, items []T, tenant func(v T) *xelon.Tenant) []T {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		return items
+	}
+
+	var found []T
+	for _, item := range items {
+		if t := tenant(item); t != nil && t.ID == tenantID {
+			found = append(found, item)
+		}
+	}
+	return found
+}
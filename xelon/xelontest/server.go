@@ -0,0 +1,382 @@
+// Package xelontest provides a stateful in-memory fake of the Xelon API for unit tests
+// of code built on top of the xelon package.
+//
+// The fake server models devices, networks, firewalls, load balancers, DNS zones and
+// records, object storage users and buckets, SSH keys, tenants, Kubernetes clusters and
+// device snapshots.
+// Resources created through the API can be inspected and changed with the stores of
+// Server, and faults like latency or error responses can be injected with InjectFault:
+//
+//	server := xelontest.NewServer()
+//	defer server.Close()
+//
+//	client := server.Client()
+//	device, _, err := client.Devices.Create(ctx, &xelon.DeviceCreateRequest{...})
+package xelontest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+const (
+	defaultPerPage = 10
+	defaultToken   = "xelontest-token"
+)
+
+// Server is a fake Xelon API server. The first tenant in Tenants is the current tenant
+// which owns all resources created through the API.
+type Server struct {
+	*httptest.Server
+
+	Tenants              *Store[xelon.Tenant]
+	Devices              *Store[xelon.Device]
+	Networks             *Store[xelon.Network]
+	Firewalls            *Store[xelon.Firewall]
+	LoadBalancers        *Store[xelon.LoadBalancer]
+	DNSZones             *Store[xelon.DNSZone]
+	DNSRecords           *Store[DNSRecord]
+	ObjectStorageUsers   *Store[xelon.ObjectStorageUser]
+	ObjectStorageBuckets *Store[xelon.ObjectStorageBucket]
+	SSHKeys              *Store[xelon.SSHKey]
+	KubernetesClusters   *Store[xelon.KubernetesCluster]
+	Snapshots            *Store[Snapshot]
+
+	mux *http.ServeMux
+	seq atomic.Int64
+
+	mu     sync.Mutex
+	faults []*Fault
+}
+
+// DNSRecord is a DNS record stored together with the zone it belongs to.
+type DNSRecord struct {
+	ZoneID string
+	xelon.DNSRecord
+}
+
+// Fault describes a failure injected into matching requests, see Server.InjectFault.
+type Fault struct {
+	// Method is the HTTP method of matching requests. Empty matches any method.
+	Method string
+
+	// Path is a pattern for the request path relative to the API root in path.Match
+	// syntax, e.g. "devices/*". Empty matches any path.
+	Path string
+
+	// Latency delays the response.
+	Latency time.Duration
+
+	// StatusCode is returned with an error body instead of handling the request, if set.
+	StatusCode int
+
+	// Message is the error message, the status text by default.
+	Message string
+
+	// Header is added to the error response, e.g. Retry-After.
+	Header http.Header
+
+	// Times limits how often the fault is injected. Zero injects it for every request.
+	Times int
+}
+
+// NewServer starts and returns a new fake server with a single tenant.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{mux: http.NewServeMux()}
+	s.Tenants = newStore(func(v xelon.Tenant) string { return v.ID }, func(v *xelon.Tenant) { v.ID = s.newID() })
+	s.Devices = newStore(func(v xelon.Device) string { return v.ID }, func(v *xelon.Device) { v.ID = s.newID() })
+	s.Networks = newStore(func(v xelon.Network) string { return v.ID }, func(v *xelon.Network) { v.ID = s.newID() })
+	s.Firewalls = newStore(func(v xelon.Firewall) string { return v.ID }, func(v *xelon.Firewall) { v.ID = s.newID() })
+	s.LoadBalancers = newStore(func(v xelon.LoadBalancer) string { return v.ID }, func(v *xelon.LoadBalancer) { v.ID = s.newID() })
+	s.DNSZones = newStore(func(v xelon.DNSZone) string { return v.ID }, func(v *xelon.DNSZone) { v.ID = s.newID() })
+	s.DNSRecords = newStore(
+		func(v DNSRecord) string {
+			if v.ID == 0 {
+				return ""
+			}
+			return strconv.Itoa(v.ID)
+		},
+		func(v *DNSRecord) { v.ID = int(s.seq.Add(1)) },
+	)
+	s.ObjectStorageUsers = newStore(func(v xelon.ObjectStorageUser) string { return v.ID }, func(v *xelon.ObjectStorageUser) { v.ID = s.newID() })
+	s.ObjectStorageBuckets = newStore(func(v xelon.ObjectStorageBucket) string { return v.ID }, func(v *xelon.ObjectStorageBucket) { v.ID = s.newID() })
+	s.SSHKeys = newStore(func(v xelon.SSHKey) string { return v.ID }, func(v *xelon.SSHKey) { v.ID = s.newID() })
+	s.KubernetesClusters = newStore(func(v xelon.KubernetesCluster) string { return v.ID }, func(v *xelon.KubernetesCluster) { v.ID = s.newID() })
+	s.Snapshots = newStore(func(v Snapshot) string { return v.ID }, func(v *Snapshot) { v.ID = s.newID() })
+
+	s.Tenants.Add(xelon.Tenant{Name: "Xelon Test Tenant", Status: "active", Type: "organization"})
+
+	s.registerDevices()
+	s.registerNetworks()
+	s.registerFirewalls()
+	s.registerLoadBalancers()
+	s.registerDomains()
+	s.registerObjectStorages()
+	s.registerSSHKeys()
+	s.registerTenants()
+	s.registerKubernetes()
+	s.registerSnapshots()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a xelon.Client configured to talk to the fake server.
+// Explicitly passed opts override the defaults.
+func (s *Server) Client(opts ...xelon.ClientOption) *xelon.Client {
+	defaults := []xelon.ClientOption{
+		xelon.WithBaseURL(s.URL + "/"),
+		xelon.WithHTTPClient(s.Server.Client()),
+	}
+	return xelon.NewClient(defaultToken, append(defaults, opts...)...)
+}
+
+// InjectFault injects fault into all subsequent matching requests. If several faults
+// match a request, the first injected one is applied.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// CurrentTenant returns the tenant owning resources created through the API.
+func (s *Server) CurrentTenant() xelon.Tenant {
+	tenants := s.Tenants.List()
+	if len(tenants) == 0 {
+		return xelon.Tenant{}
+	}
+	return tenants[0]
+}
+
+func (s *Server) newID() string {
+	return fmt.Sprintf("%012x", s.seq.Add(1))
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if fault := s.fault(r); fault != nil {
+		if fault.Latency > 0 {
+			timer := time.NewTimer(fault.Latency)
+			select {
+			case <-r.Context().Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+		if fault.StatusCode != 0 {
+			for key, values := range fault.Header {
+				w.Header()[key] = values
+			}
+			message := fault.Message
+			if message == "" {
+				message = http.StatusText(fault.StatusCode)
+			}
+			writeError(w, fault.StatusCode, message)
+			return
+		}
+	}
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "Unauthenticated.")
+		return
+	}
+
+	// unknown routes and methods are answered with the JSON error envelope of the API
+	if _, pattern := s.mux.Handler(r); pattern == "" {
+		w = &errorWriter{ResponseWriter: w}
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// fault returns the first fault matching r and consumes it.
+func (s *Server) fault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	requestPath := strings.TrimPrefix(r.URL.Path, "/")
+	for i, fault := range s.faults {
+		if fault.Method != "" && fault.Method != r.Method {
+			continue
+		}
+		if fault.Path != "" {
+			if ok, _ := path.Match(fault.Path, requestPath); !ok {
+				continue
+			}
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+// errorWriter replaces the plain text body of the ServeMux 404 and 405 responses
+// with the JSON error envelope.
+type errorWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *errorWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.ResponseWriter.Header().Del("X-Content-Type-Options")
+	writeError(w.ResponseWriter, statusCode, http.StatusText(statusCode))
+}
+
+func (w *errorWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return len(data), nil
+}
+
+// errorBody is the error envelope of the API, see xelon.ErrorElement.
+type errorBody struct {
+	Error   string              `json:"error,omitempty"`
+	Message string              `json:"message,omitempty"`
+	Errors  map[string][]string `json:"errors,omitempty"`
+}
+
+type dataRoot struct {
+	Data    any    `json:"data"`
+	Message string `json:"message,omitempty"`
+}
+
+type listRoot[T any] struct {
+	Data []T         `json:"data"`
+	Meta *xelon.Meta `json:"meta"`
+}
+
+type messageRoot struct {
+	Message string `json:"message"`
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, errorBody{Error: http.StatusText(statusCode), Message: message})
+}
+
+func writeNotFound(w http.ResponseWriter, resource string) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("%v not found", resource))
+}
+
+func writeMessage(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusOK, messageRoot{Message: message})
+}
+
+// decode decodes the JSON request body into v and writes an error response on failure.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed request body: %v", err))
+		return false
+	}
+	return true
+}
+
+// writePage writes the requested page of items with pagination metadata.
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	page = max(page, 1)
+	perPage, _ := strconv.Atoi(query.Get("perPage"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	total := len(items)
+	start := min((page-1)*perPage, total)
+	end := min(start+perPage, total)
+	meta := &xelon.Meta{
+		LastPage: max((total+perPage-1)/perPage, 1),
+		Page:     page,
+		PerPage:  perPage,
+		Total:    total,
+	}
+	if start < end {
+		meta.From = start + 1
+		meta.To = end
+	}
+
+	writeJSON(w, http.StatusOK, listRoot[T]{Data: append([]T{}, items[start:end]...), Meta: meta})
+}
+
+// search returns the items whose name contains the "search" query parameter, ignoring case.
+func search[T any](r *http.Request, items []T, name func(v T) string) []T {
+	term := strings.ToLower(r.URL.Query().Get("search"))
+	if term == "" {
+		return items
+	}
+
+	var found []T
+	for _, item := range items {
+		if strings.Contains(strings.ToLower(name(item)), term) {
+			found = append(found, item)
+		}
+	}
+	return found
+}
+
+// validation collects field errors in the format of the API.
+type validation map[string][]string
+
+// require adds a "field is required" error for field if ok is false.
+func (v validation) require(field string, ok bool) {
+	v.check(field, ok, fmt.Sprintf("The %v field is required.", field))
+}
+
+// check adds message for field if ok is false.
+func (v validation) check(field string, ok bool, message string) {
+	if !ok {
+		v[field] = append(v[field], message)
+	}
+}
+
+// write writes a 422 response if there are field errors and reports whether it did.
+func (v validation) write(w http.ResponseWriter) bool {
+	if len(v) == 0 {
+		return false
+	}
+	writeJSON(w, http.StatusUnprocessableEntity, errorBody{
+		Error:   http.StatusText(http.StatusUnprocessableEntity),
+		Message: "The given data was invalid.",
+		Errors:  v,
+	})
+	return true
+}
+
+func now() *time.Time {
+	t := time.Now().UTC().Truncate(time.Second)
+	return &t
+}
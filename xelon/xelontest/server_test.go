@@ -0,0 +1,292 @@
+package xelontest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+	"github.com/Xelon-AG/xelon-sdk-go/xelon/xelontest"
+)
+
+func newDeviceCreateRequest(name string) *xelon.DeviceCreateRequest {
+	return &xelon.DeviceCreateRequest{
+		CPUCores:             2,
+		DiskSize:             20,
+		DisplayName:          name,
+		HostName:             name,
+		Password:             "secret",
+		PasswordConfirmation: "secret",
+		RAM:                  4,
+		TemplateID:           "template-1",
+	}
+}
+
+func TestServer_devices(t *testing.T) {
+	server := xelontest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	created, _, err := client.Devices.Create(ctx, newDeviceCreateRequest("web-1"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, server.CurrentTenant().ID, created.TenantID)
+
+	_, err = client.Devices.Stop(ctx, created.ID)
+	require.NoError(t, err)
+	device, _, err := client.Devices.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.False(t, device.PoweredOn)
+
+	device, _, err = client.Devices.Power(ctx, created.ID, xelon.PowerActionResume, &xelon.PowerOptions{Wait: true})
+	require.NoError(t, err)
+	assert.True(t, device.PoweredOn)
+
+	updated, _, err := client.Devices.Update(ctx, created.ID, &xelon.DeviceUpdateRequest{DisplayName: "web-2"})
+	require.NoError(t, err)
+	assert.Equal(t, "web-2", updated.DisplayName)
+
+	_, err = client.Devices.Delete(ctx, created.ID)
+	require.NoError(t, err)
+	_, _, err = client.Devices.Get(ctx, created.ID)
+	assert.True(t, xelon.IsNotFound(err))
+}
+
+func TestServer_pagination(t *testing.T) {
+	server := xelontest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	for range 25 {
+		server.SSHKeys.Add(xelon.SSHKey{Name: "key", PublicKey: "ssh-ed25519 AAAA"})
+	}
+
+	sshKeys, resp, err := client.SSHKeys.List(context.Background(), &xelon.SSHKeyListOptions{
+		ListOptions: xelon.ListOptions{Page: 3, PerPage: 10},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, sshKeys, 5)
+	assert.Equal(t, &xelon.Meta{From: 21, LastPage: 3, Page: 3, PerPage: 10, To: 25, Total: 25}, resp.Meta)
+
+	count := 0
+	devices, iterErr := client.Devices.All(context.Background(), nil)
+	for range devices {
+		count++
+	}
+	assert.NoError(t, iterErr())
+	assert.Zero(t, count)
+}
+
+func TestServer_validation(t *testing.T) {
+	server := xelontest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	createRequest := newDeviceCreateRequest("")
+	createRequest.PasswordConfirmation = "other"
+	_, _, err := client.Devices.Create(context.Background(), createRequest)
+
+	require.ErrorIs(t, err, xelon.ErrValidation)
+	var errorResponse *xelon.ErrorResponse
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, xelon.FieldErrors{
+		{Field: "displayName", Messages: []string{"The displayName field is required."}},
+		{Field: "hostName", Messages: []string{"The hostName field is required."}},
+		{Field: "passwordConfirmation", Messages: []string{"The password confirmation does not match."}},
+	}, errorResponse.ErrorElement.Validations)
+}
+
+func TestServer_unknownRoute(t *testing.T) {
+	server := xelontest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	_, _, err := client.Templates.List(context.Background(), nil)
+
+	assert.True(t, xelon.IsNotFound(err))
+}
+
+func TestServer_unauthorized(t *testing.T) {
+	server := xelontest.NewServer()
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/devices")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+}
+
+func TestServer_InjectFault(t *testing.T) {
+	server := xelontest.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+
+	t.Run("status code", func(t *testing.T) {
+		server.InjectFault(xelontest.Fault{Method: http.MethodGet, Path: "tenants/*", StatusCode: http.StatusServiceUnavailable, Times: 1})
+		client := server.Client()
+
+		_, resp, err := client.Tenants.GetCurrent(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+		_, _, err = client.Tenants.GetCurrent(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("retried status code", func(t *testing.T) {
+		server.InjectFault(xelontest.Fault{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": {"0"}},
+			Times:      2,
+		})
+		client := server.Client(xelon.WithRetryPolicy(xelon.DefaultRetryPolicy()))
+
+		_, resp, err := client.Tenants.GetCurrent(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		server.InjectFault(xelontest.Fault{Latency: time.Second})
+		defer server.ClearFaults()
+		client := server.Client()
+
+		ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		_, _, err := client.Tenants.GetCurrent(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestServer_domains(t *testing.T) {
+	server := xelontest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	zone, _, err := client.Domains.CreateZone(ctx, &xelon.DNSZoneCreateRequest{Domain: "example.com"})
+	require.NoError(t, err)
+	_, _, err = client.Domains.CreateZone(ctx, &xelon.DNSZoneCreateRequest{Domain: "example.com"})
+	assert.ErrorIs(t, err, xelon.ErrValidation)
+
+	_, err = client.Domains.CreateRecord(ctx, zone.ID, &xelon.DNSRecordCreateRequest{Host: "www", Record: "192.0.2.1", TTL: 300, Type: xelon.DNSRecordTypeA})
+	require.NoError(t, err)
+
+	records, _, err := client.Domains.ListRecords(ctx, zone.ID)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "www", records[0].Host)
+
+	_, err = client.Domains.DeleteRecord(ctx, zone.ID, records[0].ID)
+	require.NoError(t, err)
+	assert.Zero(t, server.DNSRecords.Len())
+}
+
+func TestServer_objectStorages(t *testing.T) {
+	server := xelontest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	user, _, err := client.ObjectStorages.CreateUser(ctx, &xelon.ObjectStorageUserCreateRequest{Name: "backup", QuotaGB: 100, RegionID: "zurich"})
+	require.NoError(t, err)
+
+	token, _, err := client.ObjectStorages.CreateUserToken(ctx, user.ID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, token.SecretKey)
+	stored, _, err := client.ObjectStorages.GetUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.Tokens, 1)
+	assert.Empty(t, stored.Tokens[0].SecretKey)
+
+	bucket, _, err := client.ObjectStorages.CreateBucket(ctx, &xelon.ObjectStorageBucketCreateRequest{Name: "logs", ObjectStorageUserID: user.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "backup", bucket.ObjectStorageUserName)
+
+	_, err = client.ObjectStorages.DeleteUser(ctx, user.ID)
+	assert.ErrorIs(t, err, xelon.ErrConflict)
+	_, err = client.ObjectStorages.DeleteBucket(ctx, "logs", user.ID)
+	require.NoError(t, err)
+	_, err = client.ObjectStorages.DeleteUser(ctx, user.ID)
+	assert.NoError(t, err)
+}
+
+func TestServer_kubernetes(t *testing.T) {
+	server := xelontest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	cluster, _, err := client.Kubernetes.Create(ctx, &xelon.KubernetesClusterCreateRequest{
+		CloudID:           "cloud-1",
+		KubernetesVersion: "1.34",
+		Name:              "production",
+		WorkerPools:       []xelon.KubernetesClusterCreateRequestWorkerPool{{Name: "default", NodeCount: 3}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, xelon.KubernetesClusterStatusProvisioning, cluster.Status)
+
+	opts := &xelon.WaitOptions{
+		Interval: time.Millisecond,
+		OnProgress: func(xelon.WaitProgress) {
+			server.KubernetesClusters.Update(cluster.ID, func(v *xelon.KubernetesCluster) { v.Status = xelon.KubernetesClusterStatusReady })
+		},
+	}
+	cluster, err = client.Kubernetes.WaitForStatus(ctx, cluster.ID, xelon.KubernetesClusterStatusReady, opts)
+	require.NoError(t, err)
+	assert.Equal(t, xelon.KubernetesClusterStatusReady, cluster.Status)
+}
+
+func TestServer_snapshots(t *testing.T) {
+	server := xelontest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	device, _, err := client.Devices.Create(ctx, newDeviceCreateRequest("web-1"))
+	require.NoError(t, err)
+
+	ready := func(snapshotID string) *xelon.WaitOptions {
+		return &xelon.WaitOptions{
+			Interval: time.Millisecond,
+			OnProgress: func(xelon.WaitProgress) {
+				server.Snapshots.Update(snapshotID, func(v *xelontest.Snapshot) { v.Status = xelon.SnapshotStatusReady })
+			},
+		}
+	}
+
+	first, _, err := client.Snapshots.Create(ctx, device.ID, &xelon.SnapshotCreateRequest{Name: "pre-change"})
+	require.NoError(t, err)
+	assert.Equal(t, xelon.SnapshotStatusCreating, first.Status)
+	_, err = client.Snapshots.WaitForStatus(ctx, device.ID, first.ID, xelon.SnapshotStatusReady, ready(first.ID))
+	require.NoError(t, err)
+
+	second, _, err := client.Snapshots.Create(ctx, device.ID, &xelon.SnapshotCreateRequest{Name: "post-change"})
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, second.ParentID)
+
+	_, err = client.Snapshots.Revert(ctx, device.ID, first.ID)
+	require.NoError(t, err)
+	reverting, _, err := client.Snapshots.Get(ctx, device.ID, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, xelon.SnapshotStatusReverting, reverting.Status)
+
+	reverted, err := client.Snapshots.WaitForRevert(ctx, device.ID, first.ID, ready(first.ID))
+	require.NoError(t, err)
+	assert.True(t, reverted.Current)
+	assert.Equal(t, xelon.SnapshotStatusReady, reverted.Status)
+
+	tree, err := client.Snapshots.Tree(ctx, device.ID)
+	require.NoError(t, err)
+	if current := tree.Current(); assert.NotNil(t, current) {
+		assert.Equal(t, first.ID, current.Snapshot.ID)
+	}
+}
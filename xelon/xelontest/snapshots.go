@@ -0,0 +1,170 @@
+package xelontest
+
+import (
+	"net/http"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+// Snapshot is a device snapshot stored together with the device it belongs to.
+//
+// Snapshots are created with status xelon.SnapshotStatusCreating and a reverted
+// snapshot gets status xelon.SnapshotStatusReverting. Tests can advance them to
+// xelon.SnapshotStatusReady with the Snapshots store of Server.
+type Snapshot struct {
+	DeviceID string
+	xelon.Snapshot
+}
+
+func (s *Server) registerSnapshots() {
+	s.mux.HandleFunc("GET /devices/{id}/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.Devices.Get(r.PathValue("id")); !ok {
+			writeNotFound(w, "device")
+			return
+		}
+		writePage(w, r, s.deviceSnapshots(r.PathValue("id")))
+	})
+
+	s.mux.HandleFunc("GET /devices/{id}/snapshots/{snapshotID}", func(w http.ResponseWriter, r *http.Request) {
+		snapshot, ok := s.snapshot(r)
+		if !ok {
+			writeNotFound(w, "snapshot")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: snapshot.Snapshot})
+	})
+
+	s.mux.HandleFunc("POST /devices/{id}/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.SnapshotCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		deviceID := r.PathValue("id")
+		if _, ok := s.Devices.Get(deviceID); !ok {
+			writeNotFound(w, "device")
+			return
+		}
+		v := validation{}
+		v.require("name", createRequest.Name != "")
+		if v.write(w) {
+			return
+		}
+
+		// the new snapshot is the child of the current one and becomes current itself
+		var parentID string
+		for _, snapshot := range s.deviceSnapshots(deviceID) {
+			if snapshot.Current {
+				parentID = snapshot.ID
+			}
+		}
+		s.setCurrentSnapshot(deviceID, "")
+		snapshot := s.Snapshots.Add(Snapshot{
+			DeviceID: deviceID,
+			Snapshot: xelon.Snapshot{
+				CreatedAt:   now(),
+				Current:     true,
+				Description: createRequest.Description,
+				Name:        createRequest.Name,
+				ParentID:    parentID,
+				Status:      xelon.SnapshotStatusCreating,
+			},
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: snapshot.Snapshot, Message: "Snapshot is being created."})
+	})
+
+	s.mux.HandleFunc("PATCH /devices/{id}/snapshots/{snapshotID}", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.SnapshotUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		if _, ok := s.snapshot(r); !ok {
+			writeNotFound(w, "snapshot")
+			return
+		}
+		v := validation{}
+		v.require("name", updateRequest.Name != "")
+		if v.write(w) {
+			return
+		}
+
+		snapshot, _ := s.Snapshots.Update(r.PathValue("snapshotID"), func(snapshot *Snapshot) {
+			snapshot.Name = updateRequest.Name
+			snapshot.Description = updateRequest.Description
+		})
+		writeJSON(w, http.StatusOK, dataRoot{Data: snapshot.Snapshot})
+	})
+
+	s.mux.HandleFunc("POST /devices/{id}/snapshots/{snapshotID}/revert", func(w http.ResponseWriter, r *http.Request) {
+		snapshot, ok := s.snapshot(r)
+		if !ok {
+			writeNotFound(w, "snapshot")
+			return
+		}
+
+		s.setCurrentSnapshot(snapshot.DeviceID, snapshot.ID)
+		s.Snapshots.Update(snapshot.ID, func(snapshot *Snapshot) {
+			snapshot.Status = xelon.SnapshotStatusReverting
+		})
+		writeMessage(w, "Snapshot is being reverted.")
+	})
+
+	s.mux.HandleFunc("DELETE /devices/{id}/snapshots/{snapshotID}", func(w http.ResponseWriter, r *http.Request) {
+		var deleteRequest xelon.SnapshotDeleteRequest
+		if !decode(w, r, &deleteRequest) {
+			return
+		}
+
+		snapshot, ok := s.snapshot(r)
+		if !ok {
+			writeNotFound(w, "snapshot")
+			return
+		}
+
+		// children are removed with the snapshot or attached to its parent
+		for _, child := range s.deviceSnapshots(snapshot.DeviceID) {
+			if child.ParentID != snapshot.ID {
+				continue
+			}
+			if deleteRequest.RemoveChildSnapshots {
+				s.Snapshots.Delete(child.ID)
+				continue
+			}
+			s.Snapshots.Update(child.ID, func(child *Snapshot) { child.ParentID = snapshot.ParentID })
+		}
+		s.Snapshots.Delete(snapshot.ID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// snapshot returns the snapshot identified by the "snapshotID" path value, if it
+// belongs to the device identified by the "id" path value.
+func (s *Server) snapshot(r *http.Request) (Snapshot, bool) {
+	snapshot, ok := s.Snapshots.Get(r.PathValue("snapshotID"))
+	if !ok || snapshot.DeviceID != r.PathValue("id") {
+		return Snapshot{}, false
+	}
+	return snapshot, true
+}
+
+// deviceSnapshots returns the snapshots of the device identified by deviceID.
+func (s *Server) deviceSnapshots(deviceID string) []xelon.Snapshot {
+	snapshots := []xelon.Snapshot{}
+	for _, snapshot := range s.Snapshots.List() {
+		if snapshot.DeviceID == deviceID {
+			snapshots = append(snapshots, snapshot.Snapshot)
+		}
+	}
+	return snapshots
+}
+
+// setCurrentSnapshot marks the snapshot identified by snapshotID as the only current
+// snapshot of the device identified by deviceID.
+func (s *Server) setCurrentSnapshot(deviceID, snapshotID string) {
+	for _, snapshot := range s.deviceSnapshots(deviceID) {
+		s.Snapshots.Update(snapshot.ID, func(snapshot *Snapshot) {
+			snapshot.Current = snapshot.ID == snapshotID
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package xelontest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func (s *Server) registerSSHKeys() {
+	s.mux.HandleFunc("GET /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		sshKeys := search(r, s.SSHKeys.List(), func(v xelon.SSHKey) string { return v.Name })
+		writePage(w, r, sshKeys)
+	})
+
+	s.mux.HandleFunc("GET /ssh-keys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		sshKey, ok := s.SSHKeys.Get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "ssh key")
+			return
+		}
+		writeJSON(w, http.StatusOK, sshKey)
+	})
+
+	s.mux.HandleFunc("POST /ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.SSHKeyCreateRequest
+		if !decode(w, r, &createRequest) {
+			return
+		}
+
+		if validateSSHKey(createRequest.SSHKey).write(w) {
+			return
+		}
+
+		sshKey := s.SSHKeys.Add(xelon.SSHKey{
+			CreatedAt: now(),
+			Name:      createRequest.Name,
+			PublicKey: createRequest.PublicKey,
+		})
+		writeJSON(w, http.StatusCreated, dataRoot{Data: sshKey, Message: "SSH key created"})
+	})
+
+	s.mux.HandleFunc("PATCH /ssh-keys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var updateRequest xelon.SSHKeyUpdateRequest
+		if !decode(w, r, &updateRequest) {
+			return
+		}
+
+		if validateSSHKey(updateRequest.SSHKey).write(w) {
+			return
+		}
+
+		sshKey, ok := s.SSHKeys.Update(r.PathValue("id"), func(sshKey *xelon.SSHKey) {
+			sshKey.Name = updateRequest.Name
+			sshKey.PublicKey = updateRequest.PublicKey
+		})
+		if !ok {
+			writeNotFound(w, "ssh key")
+			return
+		}
+		writeJSON(w, http.StatusOK, dataRoot{Data: sshKey, Message: "SSH key updated"})
+	})
+
+	s.mux.HandleFunc("DELETE /ssh-keys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.SSHKeys.Delete(r.PathValue("id")) {
+			writeNotFound(w, "ssh key")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func validateSSHKey(sshKey xelon.SSHKey) validation {
+	v := validation{}
+	v.require("name", sshKey.Name != "")
+	v.require("sshKey", sshKey.PublicKey != "")
+	v.check("sshKey", sshKey.PublicKey == "" || strings.HasPrefix(sshKey.PublicKey, "ssh-") || strings.HasPrefix(sshKey.PublicKey, "ecdsa-"),
+		"The ssh key must be a valid public key.")
+	return v
+}
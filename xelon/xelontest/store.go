@@ -0,0 +1,112 @@
+package xelontest
+
+import (
+	"slices"
+	"sync"
+)
+
+// Store is an in-memory collection of resources of a single type kept by Server.
+// Tests can use it to seed resources or to change their state, e.g. to power off
+// a device. It is safe for concurrent use.
+type Store[T any] struct {
+	mu    sync.Mutex
+	items map[string]T
+	order []string
+
+	key    func(v T) string // returns the identifier of v
+	assign func(v *T)       // assigns a new identifier to v
+}
+
+func newStore[T any](key func(v T) string, assign func(v *T)) *Store[T] {
+	return &Store[T]{
+		items:  make(map[string]T),
+		key:    key,
+		assign: assign,
+	}
+}
+
+// Add stores v and returns it. If v has no identifier, a new one is assigned.
+// An existing resource with the same identifier is replaced.
+func (s *Store[T]) Add(v T) T {
+	if s.key(v) == "" {
+		s.assign(&v)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.key(v)
+	if _, ok := s.items[id]; !ok {
+		s.order = append(s.order, id)
+	}
+	s.items[id] = v
+	return v
+}
+
+// Get returns the resource identified by id.
+func (s *Store[T]) Get(id string) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.items[id]
+	return v, ok
+}
+
+// Find returns the first resource in insertion order for which match reports true.
+func (s *Store[T]) Find(match func(v T) bool) (T, bool) {
+	for _, v := range s.List() {
+		if match(v) {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// List returns all resources in insertion order.
+func (s *Store[T]) List() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := make([]T, 0, len(s.order))
+	for _, id := range s.order {
+		items = append(items, s.items[id])
+	}
+	return items
+}
+
+// Update calls fn with the resource identified by id and stores the result.
+// It returns the updated resource and false if the resource does not exist.
+func (s *Store[T]) Update(id string, fn func(v *T)) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.items[id]
+	if !ok {
+		return v, false
+	}
+	fn(&v)
+	s.items[id] = v
+	return v, true
+}
+
+// Delete removes the resource identified by id and reports whether it existed.
+func (s *Store[T]) Delete(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.items[id]; !ok {
+		return false
+	}
+	delete(s.items, id)
+	s.order = slices.DeleteFunc(s.order, func(key string) bool { return key == id })
+	return true
+}
+
+// Len returns the number of stored resources.
+func (s *Store[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.items)
+}
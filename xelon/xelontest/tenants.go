@@ -0,0 +1,31 @@
+package xelontest
+
+import (
+	"net/http"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func (s *Server) registerTenants() {
+	s.mux.HandleFunc("GET /tenants", func(w http.ResponseWriter, r *http.Request) {
+		tenants := search(r, s.Tenants.List(), func(v xelon.Tenant) string { return v.Name })
+		writePage(w, r, tenants)
+	})
+
+	s.mux.HandleFunc("GET /tenants/current", func(w http.ResponseWriter, r *http.Request) {
+		if s.Tenants.Len() == 0 {
+			writeNotFound(w, "tenant")
+			return
+		}
+		writeJSON(w, http.StatusOK, s.CurrentTenant())
+	})
+
+	s.mux.HandleFunc("GET /tenants/{id}", func(w http.ResponseWriter, r *http.Request) {
+		tenant, ok := s.Tenants.Get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, "tenant")
+			return
+		}
+		writeJSON(w, http.StatusOK, tenant)
+	})
+}